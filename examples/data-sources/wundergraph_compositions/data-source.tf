@@ -0,0 +1,21 @@
+data "wundergraph_compositions" "production" {
+  federated_graph_name = "production"
+  namespace            = "production"
+  limit                = 20
+
+  exclude_feature_flag_compositions = true
+}
+
+locals {
+  latest_valid_composition = one([
+    for c in data.wundergraph_compositions.production.compositions : c if c.is_latest_valid
+  ])
+}
+
+output "latest_valid_composition_id" {
+  value = try(local.latest_valid_composition.id, null)
+}
+
+output "latest_valid_subgraph_schema_versions" {
+  value = { for s in try(local.latest_valid_composition.subgraphs, []) : s.name => s.schema_version_id }
+}
@@ -0,0 +1,9 @@
+data "wundergraph_federated_graph" "production" {
+  name      = "my.federated.graph"
+  namespace = "production"
+}
+
+resource "local_file" "supergraph" {
+  filename = "${path.module}/supergraph.graphql"
+  content  = data.wundergraph_federated_graph.production.client_schema
+}
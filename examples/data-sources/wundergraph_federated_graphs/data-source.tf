@@ -0,0 +1,24 @@
+data "wundergraph_federated_graphs" "products" {
+  namespace = "production"
+
+  subgraph_labels = {
+    team = "products"
+  }
+}
+
+resource "wundergraph_federated_subgraph" "products" {
+  name        = "products"
+  namespace   = "production"
+  schema      = file("${path.module}/products.graphql")
+  routing_url = "https://products.example.com/graphql"
+  labels = {
+    team = "products"
+  }
+
+  lifecycle {
+    precondition {
+      condition     = length(data.wundergraph_federated_graphs.products.federated_graphs) > 0
+      error_message = "No federated graph selects the products subgraph."
+    }
+  }
+}
@@ -0,0 +1,3 @@
+data "wundergraph_namespace" "production" {
+  name = "production"
+}
@@ -0,0 +1,12 @@
+data "wundergraph_namespaces" "team-a" {
+  name_regex = "^team-a-"
+}
+
+resource "wundergraph_namespace_lint_config" "team-a" {
+  for_each = { for n in data.wundergraph_namespaces.team-a.namespaces : n.name => n }
+
+  namespace = each.key
+  rules = {
+    FIELD_NAMES_SHOULD_BE_CAMEL_CASE = "error"
+  }
+}
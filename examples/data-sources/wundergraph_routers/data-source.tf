@@ -0,0 +1,16 @@
+data "wundergraph_routers" "production" {
+  federated_graph_name = "production"
+  namespace            = "production"
+}
+
+check "router_fleet_converged" {
+  assert {
+    condition     = data.wundergraph_routers.production.converged
+    error_message = "${data.wundergraph_routers.production.routers_on_latest_composition} of ${data.wundergraph_routers.production.total_routers} routers serve the latest composition."
+  }
+
+  assert {
+    condition     = length(data.wundergraph_routers.production.service_versions) <= 1
+    error_message = "The routers run different versions: ${join(", ", data.wundergraph_routers.production.service_versions)}."
+  }
+}
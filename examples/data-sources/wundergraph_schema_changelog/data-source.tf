@@ -0,0 +1,15 @@
+data "wundergraph_schema_changelog" "production" {
+  federated_graph_name = "production"
+  namespace            = "production"
+  start_date           = "2024-07-01T00:00:00Z"
+  include_markdown     = true
+}
+
+resource "local_file" "release_notes" {
+  filename = "${path.module}/CHANGELOG.md"
+  content  = data.wundergraph_schema_changelog.production.markdown
+}
+
+output "removed_paths" {
+  value = flatten(data.wundergraph_schema_changelog.production.schema_versions[*].removed_paths)
+}
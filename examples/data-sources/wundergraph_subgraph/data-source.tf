@@ -0,0 +1,9 @@
+data "wundergraph_subgraph" "products" {
+  name                 = "products"
+  namespace            = "production"
+  federated_graph_name = "my.federated.graph"
+}
+
+output "products_routing_url" {
+  value = data.wundergraph_subgraph.products.routing_url
+}
@@ -0,0 +1,18 @@
+data "wundergraph_subgraphs" "products" {
+  namespace = "production"
+
+  labels = {
+    team = "products"
+  }
+
+  is_feature_subgraph = false
+}
+
+data "wundergraph_subgraphs" "dark_mode" {
+  namespace         = "production"
+  feature_flag_name = "dark-mode"
+}
+
+output "subgraph_routing_urls" {
+  value = { for s in data.wundergraph_subgraphs.products.subgraphs : s.name => s.routing_url }
+}
@@ -0,0 +1,6 @@
+resource "wundergraph_api_key" "ci" {
+  name                = "team-a-ci"
+  expires             = "1_year"
+  federated_graph_ids = [wundergraph_federated_graph.my-federated-graph.id]
+  subgraph_ids        = [wundergraph_federated_subgraph.my-subgraph.id]
+}
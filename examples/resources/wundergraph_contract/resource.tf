@@ -0,0 +1,7 @@
+resource "wundergraph_contract" "my-contract" {
+  name              = "my.public.graph"
+  namespace         = "default"
+  source_graph_name = "my.federated.graph"
+  routing_url       = "https://my-public-graph.com"
+  exclude_tags      = ["internal"]
+}
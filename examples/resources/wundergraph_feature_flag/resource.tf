@@ -0,0 +1,18 @@
+resource "wundergraph_federated_subgraph" "my-feature-subgraph" {
+  name                = "my.subgraph.feature"
+  namespace           = "default"
+  schema              = file("${path.module}/my-feature-subgraph.graphql")
+  routing_url         = "https://my-feature-subgraph.com"
+  is_feature_subgraph = true
+  base_subgraph_name  = "my.subgraph"
+}
+
+resource "wundergraph_feature_flag" "my-feature-flag" {
+  name      = "my-feature-flag"
+  namespace = "default"
+  labels = {
+    "some" = "label"
+  }
+  feature_subgraph_names = [wundergraph_federated_subgraph.my-feature-subgraph.name]
+  enabled                = true
+}
@@ -2,6 +2,7 @@ resource "wundergraph_federated_graph" "my-federated-graph" {
   name        = "my.federated.graph"
   namespace   = "default"
   routing_url = "https://my-federated-graph.com"
+  readme      = "Federated graph of all services."
   label_matchers = [
     {
       key    = "some"
@@ -7,6 +7,7 @@ resource "wundergraph_federated_subgraph" "my-subgraph" {
   namespace   = "default"
   schema      = data.local_file.schema.content
   routing_url = "https://my-subgraph.com"
+  readme      = file("${path.module}/README.md")
   labels = {
     "some" = "label"
   }
@@ -0,0 +1,2 @@
+# The id can be found through the GetOrganizationIntegrations endpoint of the controlplane.
+terraform import wundergraph_integration.team-a-slack 4c9e2a6e-6f5c-4d8e-9b1a-3f2c1d0e9a8b
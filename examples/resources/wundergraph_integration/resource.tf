@@ -0,0 +1,7 @@
+resource "wundergraph_integration" "team-a-slack" {
+  name = "team-a"
+
+  federated_graph_schema_updated = {
+    graph_ids = [wundergraph_federated_graph.my-federated-graph.id]
+  }
+}
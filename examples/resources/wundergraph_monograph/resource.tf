@@ -0,0 +1,11 @@
+data "local_file" "schema" {
+  filename = "${path.module}/my-monograph.graphql"
+}
+
+resource "wundergraph_monograph" "my-monograph" {
+  name        = "my.monograph"
+  namespace   = "default"
+  routing_url = "https://my-router.com"
+  graph_url   = "https://my-graphql-api.com/graphql"
+  schema      = data.local_file.schema.content
+}
@@ -0,0 +1,7 @@
+resource "wundergraph_namespace_lint_config" "my-namespace" {
+  namespace = wundergraph_namespace.my-namespace.name
+  rules = {
+    "FIELD_NAMES_SHOULD_BE_CAMEL_CASE" = "error"
+    "ALPHABETICALLY_SORT_ENUM_VALUES"  = "warn"
+  }
+}
@@ -0,0 +1,16 @@
+resource "wundergraph_oidc_provider" "sso" {
+  name               = "okta"
+  discovery_endpoint = "https://my-org.okta.com/.well-known/openid-configuration"
+  client_id          = var.oidc_client_id
+  client_secret      = var.oidc_client_secret
+  mappers = [
+    {
+      role      = "admin"
+      sso_group = "platform-team"
+    },
+    {
+      role      = "developer"
+      sso_group = "engineering"
+    }
+  ]
+}
@@ -0,0 +1,6 @@
+resource "wundergraph_operation_ignore_all_override" "legacy-dashboard" {
+  graph_name     = wundergraph_federated_graph.my-federated-graph.name
+  namespace      = wundergraph_namespace.my-namespace.name
+  operation_hash = "0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9"
+  operation_name = "LegacyDashboard"
+}
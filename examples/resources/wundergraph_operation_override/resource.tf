@@ -0,0 +1,13 @@
+resource "wundergraph_operation_override" "employees" {
+  graph_name     = wundergraph_federated_graph.my-federated-graph.name
+  namespace      = wundergraph_namespace.my-namespace.name
+  operation_hash = "5bf3d7a1c0e4c4a3b5e1f8f1b2a6d7c9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5"
+  operation_name = "Employees"
+
+  changes = [
+    {
+      change_type = "FIELD_REMOVED"
+      path        = "Employee.department"
+    }
+  ]
+}
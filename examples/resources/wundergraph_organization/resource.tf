@@ -0,0 +1,4 @@
+resource "wundergraph_organization" "production" {
+  name = "Acme Production"
+  slug = "acme-production"
+}
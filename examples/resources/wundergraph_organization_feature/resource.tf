@@ -0,0 +1,7 @@
+resource "wundergraph_organization_feature" "rbac" {
+  feature = "rbac"
+  enabled = true
+
+  # Disable RBAC again when the resource is destroyed.
+  previous_enabled = false
+}
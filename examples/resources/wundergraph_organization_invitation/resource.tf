@@ -0,0 +1,3 @@
+resource "wundergraph_organization_invitation" "jane" {
+  email = "jane@example.com"
+}
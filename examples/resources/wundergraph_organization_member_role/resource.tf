@@ -0,0 +1,4 @@
+resource "wundergraph_organization_member_role" "jane" {
+  email = wundergraph_organization_invitation.jane.email
+  role  = "developer"
+}
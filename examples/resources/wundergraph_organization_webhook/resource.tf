@@ -0,0 +1,12 @@
+resource "wundergraph_organization_webhook" "schema-updates" {
+  endpoint = "https://hooks.example.com/cosmo"
+  key      = var.webhook_key
+
+  federated_graph_schema_updated = {
+    graph_ids = [wundergraph_federated_graph.my-federated-graph.id]
+  }
+
+  monograph_schema_updated = {
+    graph_ids = [wundergraph_monograph.my-monograph.id]
+  }
+}
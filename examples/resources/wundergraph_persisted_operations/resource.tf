@@ -0,0 +1,18 @@
+resource "wundergraph_persisted_operations" "web" {
+  federated_graph_name = wundergraph_federated_graph.my-federated-graph.name
+  namespace            = wundergraph_namespace.my-namespace.name
+  client_name          = "web"
+
+  # Apollo persisted query manifest or Relay manifest generated by the frontend build.
+  manifest_file = "${path.module}/persisted-query-manifest.json"
+}
+
+resource "wundergraph_persisted_operations" "mobile" {
+  federated_graph_name = wundergraph_federated_graph.my-federated-graph.name
+  namespace            = wundergraph_namespace.my-namespace.name
+  client_name          = "mobile"
+
+  operations = {
+    "employees" = "query Employees { employees { id } }"
+  }
+}
@@ -0,0 +1,15 @@
+resource "wundergraph_router_token" "my-router-token" {
+  graph_name = "my.federated.graph"
+  namespace  = "default"
+  token_name = "my-router"
+}
+
+resource "kubernetes_secret" "router" {
+  metadata {
+    name = "router"
+  }
+
+  data = {
+    GRAPH_API_TOKEN = wundergraph_router_token.my-router-token.token
+  }
+}
@@ -0,0 +1,5 @@
+resource "wundergraph_subgraph_member" "my-subgraph-owner" {
+  subgraph_name = wundergraph_federated_subgraph.my-subgraph.name
+  namespace     = wundergraph_federated_subgraph.my-subgraph.namespace
+  email         = "jane@example.com"
+}
@@ -0,0 +1,288 @@
+package datasources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CompositionsDataSource{}
+
+func NewCompositionsDataSource() datasource.DataSource {
+	return &CompositionsDataSource{}
+}
+
+// CompositionsDataSource defines the data source implementation.
+type CompositionsDataSource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// CompositionsModel describes the data source data model.
+type CompositionsModel struct {
+	FederatedGraphName             types.String       `tfsdk:"federated_graph_name"`
+	Namespace                      types.String       `tfsdk:"namespace"`
+	StartDate                      types.String       `tfsdk:"start_date"`
+	EndDate                        types.String       `tfsdk:"end_date"`
+	Limit                          types.Int64        `tfsdk:"limit"`
+	ExcludeFeatureFlagCompositions types.Bool         `tfsdk:"exclude_feature_flag_compositions"`
+	Compositions                   []CompositionModel `tfsdk:"compositions"`
+}
+
+// CompositionModel describes a composition of a federated graph nested in a data source.
+type CompositionModel struct {
+	Id                    types.String               `tfsdk:"id"`
+	SchemaVersionId       types.String               `tfsdk:"schema_version_id"`
+	CreatedAt             types.String               `tfsdk:"created_at"`
+	CreatedBy             types.String               `tfsdk:"created_by"`
+	IsComposable          types.Bool                 `tfsdk:"is_composable"`
+	IsLatestValid         types.Bool                 `tfsdk:"is_latest_valid"`
+	CompositionErrors     types.String               `tfsdk:"composition_errors"`
+	RouterConfigSignature types.String               `tfsdk:"router_config_signature"`
+	Subgraphs             []CompositionSubgraphModel `tfsdk:"subgraphs"`
+}
+
+// CompositionSubgraphModel describes a subgraph schema version that is part of a composition.
+type CompositionSubgraphModel struct {
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	SchemaVersionId   types.String `tfsdk:"schema_version_id"`
+	TargetId          types.String `tfsdk:"target_id"`
+	IsFeatureSubgraph types.Bool   `tfsdk:"is_feature_subgraph"`
+}
+
+func (d *CompositionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compositions"
+}
+
+func (d *CompositionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the compositions of a federated graph within a date range, newest first, including the subgraph schema versions that are part of each composition.",
+		Attributes: map[string]schema.Attribute{
+			"federated_graph_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the federated graph.",
+				Required:            true,
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The namespace of the federated graph. Defaults to `default`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "The start of the date range, in RFC 3339 format. Defaults to 7 days before `end_date`. The range is limited by the analytics retention of the organization.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"end_date": schema.StringAttribute{
+				MarkdownDescription: "The end of the date range, in RFC 3339 format. Defaults to the current time.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of compositions to return. Defaults to `10`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"exclude_feature_flag_compositions": schema.BoolAttribute{
+				MarkdownDescription: "Exclude the compositions of the feature flags of the federated graph.",
+				Optional:            true,
+			},
+			"compositions": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The id of the composition.",
+						},
+						"schema_version_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The id of the composed schema version.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The time the composition was created.",
+						},
+						"created_by": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The email of the user, or the name of the API key, that triggered the composition.",
+						},
+						"is_composable": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the composition succeeded.",
+						},
+						"is_latest_valid": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether this is the latest successful composition, which is served to the routers.",
+						},
+						"composition_errors": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The errors of the composition, when it failed.",
+						},
+						"router_config_signature": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The signature of the router config of the composition, when an admission webhook is configured.",
+						},
+						"subgraphs": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The id of the subgraph.",
+									},
+									"name": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The name of the subgraph.",
+									},
+									"schema_version_id": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The id of the schema version of the subgraph that is part of the composition.",
+									},
+									"target_id": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The target id of the subgraph.",
+									},
+									"is_feature_subgraph": schema.BoolAttribute{
+										Computed:            true,
+										MarkdownDescription: "Whether the subgraph is a feature subgraph.",
+									},
+								},
+							},
+							Computed:            true,
+							MarkdownDescription: "The subgraph schema versions that are part of the composition.",
+						},
+					},
+				},
+				MarkdownDescription: "The compositions.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CompositionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// MapCompositionFromNative maps a composition and the subgraph schema versions it consists of to its data source model.
+func MapCompositionFromNative(c *platformv1.GraphComposition, subgraphs []*platformv1.GraphCompositionSubgraph) CompositionModel {
+	s := make([]CompositionSubgraphModel, 0, len(subgraphs))
+	for _, v := range subgraphs {
+		s = append(s, CompositionSubgraphModel{
+			Id:                types.StringValue(v.Id),
+			Name:              types.StringValue(v.Name),
+			SchemaVersionId:   types.StringValue(v.SchemaVersionId),
+			TargetId:          types.StringValue(v.TargetId),
+			IsFeatureSubgraph: types.BoolValue(v.IsFeatureSubgraph),
+		})
+	}
+
+	return CompositionModel{
+		Id:                    types.StringValue(c.Id),
+		SchemaVersionId:       types.StringValue(c.SchemaVersionId),
+		CreatedAt:             types.StringValue(c.CreatedAt),
+		CreatedBy:             types.StringPointerValue(nonEmpty(c.CreatedBy)),
+		IsComposable:          types.BoolValue(c.IsComposable),
+		IsLatestValid:         types.BoolValue(c.IsLatestValid),
+		CompositionErrors:     types.StringPointerValue(nonEmpty(c.CompositionErrors)),
+		RouterConfigSignature: types.StringPointerValue(nonEmpty(c.RouterConfigSignature)),
+		Subgraphs:             s,
+	}
+}
+
+func (d *CompositionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *CompositionsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Namespace.IsNull() {
+		data.Namespace = types.StringValue("default")
+	}
+
+	if data.Limit.IsNull() {
+		data.Limit = types.Int64Value(10)
+	}
+
+	startDate, endDate, err := ResolveDateRange(data.StartDate.ValueString(), data.EndDate.ValueString(), time.Now())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid date range", err.Error())
+		return
+	}
+
+	data.StartDate = types.StringValue(startDate)
+	data.EndDate = types.StringValue(endDate)
+
+	rc, err := d.client.GetCompositions(ctx, &connect.Request[platformv1.GetCompositionsRequest]{
+		Msg: &platformv1.GetCompositionsRequest{
+			FedGraphName:                   data.FederatedGraphName.ValueString(),
+			Namespace:                      data.Namespace.ValueString(),
+			StartDate:                      startDate,
+			EndDate:                        endDate,
+			Limit:                          int32(data.Limit.ValueInt64()),
+			ExcludeFeatureFlagCompositions: data.ExcludeFeatureFlagCompositions.ValueBool(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading compositions", err.Error())
+		return
+	}
+
+	if rc.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error reading compositions", rc.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	compositions := make([]CompositionModel, 0, len(rc.Msg.Compositions))
+	for _, c := range rc.Msg.Compositions {
+		rd, err := d.client.GetCompositionDetails(ctx, &connect.Request[platformv1.GetCompositionDetailsRequest]{
+			Msg: &platformv1.GetCompositionDetailsRequest{
+				CompositionId: c.Id,
+				Namespace:     data.Namespace.ValueString(),
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading composition details", err.Error())
+			return
+		}
+
+		if rd.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+			resp.Diagnostics.AddError("Error reading composition details", rd.Msg.GetResponse().GetDetails())
+			return
+		}
+
+		compositions = append(compositions, MapCompositionFromNative(c, rd.Msg.CompositionSubgraphs))
+	}
+
+	data.Compositions = compositions
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
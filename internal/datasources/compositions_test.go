@@ -0,0 +1,46 @@
+package datasources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapCompositionFromNative(t *testing.T) {
+	empty := ""
+	createdBy := "dev@example.com"
+
+	result := MapCompositionFromNative(&platformv1.GraphComposition{
+		Id:                "c1",
+		SchemaVersionId:   "v1",
+		CreatedAt:         "2024-07-29T12:00:00.000Z",
+		CreatedBy:         &createdBy,
+		IsComposable:      true,
+		IsLatestValid:     true,
+		CompositionErrors: &empty,
+	}, []*platformv1.GraphCompositionSubgraph{
+		{Id: "s1", Name: "products", SchemaVersionId: "sv1", TargetId: "t1"},
+	})
+
+	assert.Equal(t, CompositionModel{
+		Id:                    types.StringValue("c1"),
+		SchemaVersionId:       types.StringValue("v1"),
+		CreatedAt:             types.StringValue("2024-07-29T12:00:00.000Z"),
+		CreatedBy:             types.StringValue("dev@example.com"),
+		IsComposable:          types.BoolValue(true),
+		IsLatestValid:         types.BoolValue(true),
+		CompositionErrors:     types.StringNull(),
+		RouterConfigSignature: types.StringNull(),
+		Subgraphs: []CompositionSubgraphModel{
+			{
+				Id:                types.StringValue("s1"),
+				Name:              types.StringValue("products"),
+				SchemaVersionId:   types.StringValue("sv1"),
+				TargetId:          types.StringValue("t1"),
+				IsFeatureSubgraph: types.BoolValue(false),
+			},
+		},
+	}, result)
+}
@@ -0,0 +1,37 @@
+package datasources
+
+import (
+	"fmt"
+	"time"
+)
+
+// defaultDateRangePeriod is the period before the end date that is searched when no start date is set.
+const defaultDateRangePeriod = 7 * 24 * time.Hour
+
+// ResolveDateRange resolves the date range to search the history of a graph. An empty end date defaults to now and
+// an empty start date defaults to 7 days before the end date. Both dates are returned in RFC 3339 format in UTC.
+func ResolveDateRange(startDate, endDate string, now time.Time) (string, string, error) {
+	end := now
+	if endDate != "" {
+		t, err := time.Parse(time.RFC3339, endDate)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid end date %q: %w", endDate, err)
+		}
+		end = t
+	}
+
+	start := end.Add(-defaultDateRangePeriod)
+	if startDate != "" {
+		t, err := time.Parse(time.RFC3339, startDate)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid start date %q: %w", startDate, err)
+		}
+		start = t
+	}
+
+	if start.After(end) {
+		return "", "", fmt.Errorf("start date %s lies after end date %s", start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+
+	return start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339), nil
+}
@@ -0,0 +1,63 @@
+package datasources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveDateRange(t *testing.T) {
+	now := time.Date(2024, 7, 29, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		startDate     string
+		endDate       string
+		expectedStart string
+		expectedEnd   string
+		expectError   bool
+	}{
+		{
+			name:          "Defaults",
+			expectedStart: "2024-07-22T12:00:00Z",
+			expectedEnd:   "2024-07-29T12:00:00Z",
+		},
+		{
+			name:          "StartDefaultsRelativeToEnd",
+			endDate:       "2024-07-10T00:00:00Z",
+			expectedStart: "2024-07-03T00:00:00Z",
+			expectedEnd:   "2024-07-10T00:00:00Z",
+		},
+		{
+			name:          "ConvertsToUTC",
+			startDate:     "2024-07-01T02:00:00+02:00",
+			expectedStart: "2024-07-01T00:00:00Z",
+			expectedEnd:   "2024-07-29T12:00:00Z",
+		},
+		{
+			name:        "StartAfterEnd",
+			startDate:   "2024-07-30T00:00:00Z",
+			expectError: true,
+		},
+		{
+			name:        "InvalidInput",
+			endDate:     "yesterday",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := ResolveDateRange(tt.startDate, tt.endDate, now)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStart, start)
+				assert.Equal(t, tt.expectedEnd, end)
+			}
+		})
+	}
+}
@@ -0,0 +1,455 @@
+package datasources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/internal/resources"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FederatedGraphDataSource{}
+
+func NewFederatedGraphDataSource() datasource.DataSource {
+	return &FederatedGraphDataSource{}
+}
+
+// FederatedGraphDataSource defines the data source implementation.
+type FederatedGraphDataSource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// FederatedGraphModel describes the data source data model.
+type FederatedGraphModel struct {
+	Id                  types.String            `tfsdk:"id"`
+	Name                types.String            `tfsdk:"name"`
+	Namespace           types.String            `tfsdk:"namespace"`
+	RoutingUrl          types.String            `tfsdk:"routing_url"`
+	LabelMatchers       resources.LabelMatchers `tfsdk:"label_matchers"`
+	AdmissionWebhookUrl types.String            `tfsdk:"admission_webhook_url"`
+	Readme              types.String            `tfsdk:"readme"`
+	TargetId            types.String            `tfsdk:"target_id"`
+	IsComposable        types.Bool              `tfsdk:"is_composable"`
+	CompositionErrors   types.String            `tfsdk:"composition_errors"`
+	CompositionId       types.String            `tfsdk:"composition_id"`
+	ConnectedSubgraphs  types.Int64             `tfsdk:"connected_subgraphs"`
+	LastUpdatedAt       types.String            `tfsdk:"last_updated_at"`
+	Subgraphs           []SubgraphModel         `tfsdk:"subgraphs"`
+	FeatureSubgraphs    []SubgraphModel         `tfsdk:"feature_subgraphs"`
+	FeatureFlags        []FeatureFlagModel      `tfsdk:"feature_flags"`
+	SchemaVersionId     types.String            `tfsdk:"schema_version_id"`
+	RouterSchema        types.String            `tfsdk:"router_schema"`
+	ClientSchema        types.String            `tfsdk:"client_schema"`
+}
+
+// SubgraphModel describes a subgraph nested in a data source.
+type SubgraphModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Namespace            types.String `tfsdk:"namespace"`
+	RoutingUrl           types.String `tfsdk:"routing_url"`
+	SubscriptionUrl      types.String `tfsdk:"subscription_url"`
+	SubscriptionProtocol types.String `tfsdk:"subscription_protocol"`
+	WebsocketSubprotocol types.String `tfsdk:"websocket_subprotocol"`
+	Labels               types.Map    `tfsdk:"labels"`
+	TargetId             types.String `tfsdk:"target_id"`
+	IsEventDrivenGraph   types.Bool   `tfsdk:"is_event_driven_graph"`
+	IsFeatureSubgraph    types.Bool   `tfsdk:"is_feature_subgraph"`
+	BaseSubgraphName     types.String `tfsdk:"base_subgraph_name"`
+	LastUpdatedAt        types.String `tfsdk:"last_updated_at"`
+}
+
+// FeatureFlagModel describes a feature flag nested in a data source.
+type FeatureFlagModel struct {
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Namespace types.String `tfsdk:"namespace"`
+	Labels    types.Map    `tfsdk:"labels"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+}
+
+func (d *FederatedGraphDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_federated_graph"
+}
+
+func (d *FederatedGraphDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a federated graph by its name, including its composition status, subgraphs and composed schema.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the federated graph.",
+				Required:            true,
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The namespace of the federated graph. Defaults to `default`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"routing_url": schema.StringAttribute{
+				MarkdownDescription: "The routing url of the router of the federated graph.",
+				Computed:            true,
+			},
+			"label_matchers": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The key of the label matcher.",
+						},
+						"values": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "The values of the label matcher.",
+						},
+					},
+				},
+				MarkdownDescription: "The label matchers used to select the subgraphs of the federated graph.",
+				Computed:            true,
+			},
+			"admission_webhook_url": schema.StringAttribute{
+				MarkdownDescription: "The admission webhook url of the federated graph.",
+				Computed:            true,
+			},
+			"readme": schema.StringAttribute{
+				MarkdownDescription: "The markdown text which describes the federated graph.",
+				Computed:            true,
+			},
+			"target_id": schema.StringAttribute{
+				MarkdownDescription: "The target id of the federated graph, as used by API keys and analytics.",
+				Computed:            true,
+			},
+			"is_composable": schema.BoolAttribute{
+				MarkdownDescription: "Whether the latest composition of the federated graph succeeded.",
+				Computed:            true,
+			},
+			"composition_errors": schema.StringAttribute{
+				MarkdownDescription: "The errors of the latest composition, if any.",
+				Computed:            true,
+			},
+			"composition_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the latest composition.",
+				Computed:            true,
+			},
+			"connected_subgraphs": schema.Int64Attribute{
+				MarkdownDescription: "The number of subgraphs that are part of the federated graph.",
+				Computed:            true,
+			},
+			"last_updated_at": schema.StringAttribute{
+				MarkdownDescription: "The time the federated graph was last updated.",
+				Computed:            true,
+			},
+			"subgraphs": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: subgraphAttributes(),
+				},
+				MarkdownDescription: "The subgraphs of the federated graph.",
+				Computed:            true,
+			},
+			"feature_subgraphs": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: subgraphAttributes(),
+				},
+				MarkdownDescription: "The feature subgraphs of the federated graph.",
+				Computed:            true,
+			},
+			"feature_flags": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: featureFlagAttributes(),
+				},
+				MarkdownDescription: "The feature flags that are part of the latest valid composition.",
+				Computed:            true,
+			},
+			"schema_version_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the schema version of the latest valid composition.",
+				Computed:            true,
+			},
+			"router_schema": schema.StringAttribute{
+				MarkdownDescription: "The composed schema of the latest valid composition, as used by the router.",
+				Computed:            true,
+			},
+			"client_schema": schema.StringAttribute{
+				MarkdownDescription: "The schema of the latest valid composition as exposed to clients.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func subgraphAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The id of the subgraph.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the subgraph.",
+		},
+		"namespace": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The namespace of the subgraph.",
+		},
+		"routing_url": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The routing url of the subgraph.",
+		},
+		"subscription_url": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The url used for subscriptions, if it differs from the routing url.",
+		},
+		"subscription_protocol": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The protocol used for subscriptions.",
+		},
+		"websocket_subprotocol": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The subprotocol used for subscriptions over websockets.",
+		},
+		"labels": schema.MapAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			MarkdownDescription: "The labels of the subgraph.",
+		},
+		"target_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The target id of the subgraph, as used by API keys and analytics.",
+		},
+		"is_event_driven_graph": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the subgraph is an Event-Driven Graph (EDG).",
+		},
+		"is_feature_subgraph": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the subgraph is a feature subgraph.",
+		},
+		"base_subgraph_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the base subgraph of a feature subgraph.",
+		},
+		"last_updated_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The time the subgraph was last updated.",
+		},
+	}
+}
+
+func featureFlagAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The id of the feature flag.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the feature flag.",
+		},
+		"namespace": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The namespace of the feature flag.",
+		},
+		"labels": schema.MapAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			MarkdownDescription: "The labels of the feature flag.",
+		},
+		"enabled": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the feature flag is enabled.",
+		},
+	}
+}
+
+func (d *FederatedGraphDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// nonEmpty returns nil for an empty string, as the API returns empty strings for unset optional values.
+func nonEmpty(s *string) *string {
+	if s == nil || *s == "" {
+		return nil
+	}
+
+	return s
+}
+
+func MapSubgraphFromNative(ctx context.Context, s *platformv1.Subgraph) (SubgraphModel, diag.Diagnostics) {
+	labels, diags := types.MapValueFrom(ctx, types.StringType, resources.MapLabelsFromNative(s.Labels))
+	if diags.HasError() {
+		return SubgraphModel{}, diags
+	}
+
+	return SubgraphModel{
+		Id:                   types.StringValue(s.Id),
+		Name:                 types.StringValue(s.Name),
+		Namespace:            types.StringValue(s.Namespace),
+		RoutingUrl:           types.StringPointerValue(nonEmpty(&s.RoutingURL)),
+		SubscriptionUrl:      types.StringPointerValue(nonEmpty(&s.SubscriptionUrl)),
+		SubscriptionProtocol: types.StringValue(s.SubscriptionProtocol),
+		WebsocketSubprotocol: types.StringValue(s.WebsocketSubprotocol),
+		Labels:               labels,
+		TargetId:             types.StringValue(s.TargetId),
+		IsEventDrivenGraph:   types.BoolValue(s.IsEventDrivenGraph),
+		IsFeatureSubgraph:    types.BoolValue(s.IsFeatureSubgraph),
+		BaseSubgraphName:     types.StringPointerValue(nonEmpty(s.BaseSubgraphName)),
+		LastUpdatedAt:        types.StringValue(s.LastUpdatedAt),
+	}, nil
+}
+
+func MapSubgraphsFromNative(ctx context.Context, subgraphs []*platformv1.Subgraph) ([]SubgraphModel, diag.Diagnostics) {
+	s := make([]SubgraphModel, 0, len(subgraphs))
+	for _, v := range subgraphs {
+		m, diags := MapSubgraphFromNative(ctx, v)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		s = append(s, m)
+	}
+
+	return s, nil
+}
+
+func MapFeatureFlagsFromNative(ctx context.Context, featureFlags []*platformv1.FeatureFlag) ([]FeatureFlagModel, diag.Diagnostics) {
+	f := make([]FeatureFlagModel, 0, len(featureFlags))
+	for _, v := range featureFlags {
+		labels, diags := types.MapValueFrom(ctx, types.StringType, resources.MapLabelsFromNative(v.Labels))
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		f = append(f, FeatureFlagModel{
+			Id:        types.StringValue(v.Id),
+			Name:      types.StringValue(v.Name),
+			Namespace: types.StringValue(v.Namespace),
+			Labels:    labels,
+			Enabled:   types.BoolValue(v.IsEnabled),
+		})
+	}
+
+	return f, nil
+}
+
+func (d *FederatedGraphDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *FederatedGraphModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Namespace.IsNull() {
+		data.Namespace = types.StringValue("default")
+	}
+
+	rg, err := d.client.GetFederatedGraphByName(ctx, &connect.Request[platformv1.GetFederatedGraphByNameRequest]{
+		Msg: &platformv1.GetFederatedGraphByNameRequest{
+			Name:      data.Name.ValueString(),
+			Namespace: data.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading federated graph", err.Error())
+		return
+	}
+
+	if rg.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error reading federated graph", rg.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	graph := rg.Msg.Graph
+
+	labelMatchers, diags := resources.MapLabelMatchersFromNative(ctx, graph.LabelMatchers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subgraphs, diags := MapSubgraphsFromNative(ctx, rg.Msg.Subgraphs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	featureSubgraphs, diags := MapSubgraphsFromNative(ctx, rg.Msg.FeatureSubgraphs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	featureFlags, diags := MapFeatureFlagsFromNative(ctx, rg.Msg.FeatureFlagsInLatestValidComposition)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(graph.Id)
+	data.Namespace = types.StringValue(graph.Namespace)
+	data.RoutingUrl = types.StringValue(graph.RoutingURL)
+	data.LabelMatchers = labelMatchers
+	data.AdmissionWebhookUrl = types.StringPointerValue(nonEmpty(graph.AdmissionWebhookUrl))
+	data.Readme = types.StringPointerValue(nonEmpty(graph.Readme))
+	data.TargetId = types.StringValue(graph.TargetId)
+	data.IsComposable = types.BoolValue(graph.IsComposable)
+	data.CompositionErrors = types.StringPointerValue(nonEmpty(&graph.CompositionErrors))
+	data.CompositionId = types.StringPointerValue(nonEmpty(graph.CompositionId))
+	data.ConnectedSubgraphs = types.Int64Value(int64(graph.ConnectedSubgraphs))
+	data.LastUpdatedAt = types.StringValue(graph.LastUpdatedAt)
+	data.Subgraphs = subgraphs
+	data.FeatureSubgraphs = featureSubgraphs
+	data.FeatureFlags = featureFlags
+
+	rs, err := d.client.GetFederatedGraphSDLByName(ctx, &connect.Request[platformv1.GetFederatedGraphSDLByNameRequest]{
+		Msg: &platformv1.GetFederatedGraphSDLByNameRequest{
+			Name:      data.Name.ValueString(),
+			Namespace: data.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching SDL", err.Error())
+		return
+	}
+
+	// A graph without a valid composition has no schema yet.
+	switch rs.Msg.GetResponse().Code {
+	case common.EnumStatusCode_OK:
+		data.SchemaVersionId = types.StringPointerValue(rs.Msg.VersionId)
+		data.RouterSchema = types.StringPointerValue(rs.Msg.Sdl)
+		data.ClientSchema = types.StringPointerValue(nonEmpty(rs.Msg.ClientSchema))
+	case common.EnumStatusCode_ERR_NOT_FOUND:
+		data.SchemaVersionId = types.StringNull()
+		data.RouterSchema = types.StringNull()
+		data.ClientSchema = types.StringNull()
+	default:
+		resp.Diagnostics.AddError("Error fetching SDL", rs.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
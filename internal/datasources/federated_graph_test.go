@@ -0,0 +1,128 @@
+package datasources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapSubgraphFromNative(t *testing.T) {
+	base := "products"
+
+	tests := []struct {
+		name     string
+		subgraph *platformv1.Subgraph
+		expected SubgraphModel
+	}{
+		{
+			name: "Subgraph",
+			subgraph: &platformv1.Subgraph{
+				Id:                   "1",
+				Name:                 "products",
+				Namespace:            "default",
+				RoutingURL:           "https://products.example.com/graphql",
+				SubscriptionProtocol: "ws",
+				WebsocketSubprotocol: "auto",
+				Labels:               []*platformv1.Label{{Key: "team", Value: "a"}},
+				TargetId:             "t1",
+				LastUpdatedAt:        "2024-01-01T00:00:00Z",
+			},
+			expected: SubgraphModel{
+				Id:                   types.StringValue("1"),
+				Name:                 types.StringValue("products"),
+				Namespace:            types.StringValue("default"),
+				RoutingUrl:           types.StringValue("https://products.example.com/graphql"),
+				SubscriptionUrl:      types.StringNull(),
+				SubscriptionProtocol: types.StringValue("ws"),
+				WebsocketSubprotocol: types.StringValue("auto"),
+				Labels:               types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("a")}),
+				TargetId:             types.StringValue("t1"),
+				IsEventDrivenGraph:   types.BoolValue(false),
+				IsFeatureSubgraph:    types.BoolValue(false),
+				BaseSubgraphName:     types.StringNull(),
+				LastUpdatedAt:        types.StringValue("2024-01-01T00:00:00Z"),
+			},
+		},
+		{
+			name: "FeatureSubgraph",
+			subgraph: &platformv1.Subgraph{
+				Id:                "2",
+				Name:              "products-v2",
+				Namespace:         "default",
+				RoutingURL:        "https://products-v2.example.com/graphql",
+				SubscriptionUrl:   "wss://products-v2.example.com/graphql",
+				TargetId:          "t2",
+				IsFeatureSubgraph: true,
+				BaseSubgraphName:  &base,
+			},
+			expected: SubgraphModel{
+				Id:                   types.StringValue("2"),
+				Name:                 types.StringValue("products-v2"),
+				Namespace:            types.StringValue("default"),
+				RoutingUrl:           types.StringValue("https://products-v2.example.com/graphql"),
+				SubscriptionUrl:      types.StringValue("wss://products-v2.example.com/graphql"),
+				SubscriptionProtocol: types.StringValue(""),
+				WebsocketSubprotocol: types.StringValue(""),
+				Labels:               types.MapValueMust(types.StringType, map[string]attr.Value{}),
+				TargetId:             types.StringValue("t2"),
+				IsEventDrivenGraph:   types.BoolValue(false),
+				IsFeatureSubgraph:    types.BoolValue(true),
+				BaseSubgraphName:     types.StringValue("products"),
+				LastUpdatedAt:        types.StringValue(""),
+			},
+		},
+		{
+			name: "EventDrivenGraph",
+			subgraph: &platformv1.Subgraph{
+				Id:                 "3",
+				Name:               "events",
+				Namespace:          "default",
+				IsEventDrivenGraph: true,
+			},
+			expected: SubgraphModel{
+				Id:                   types.StringValue("3"),
+				Name:                 types.StringValue("events"),
+				Namespace:            types.StringValue("default"),
+				RoutingUrl:           types.StringNull(),
+				SubscriptionUrl:      types.StringNull(),
+				SubscriptionProtocol: types.StringValue(""),
+				WebsocketSubprotocol: types.StringValue(""),
+				Labels:               types.MapValueMust(types.StringType, map[string]attr.Value{}),
+				TargetId:             types.StringValue(""),
+				IsEventDrivenGraph:   types.BoolValue(true),
+				IsFeatureSubgraph:    types.BoolValue(false),
+				BaseSubgraphName:     types.StringNull(),
+				LastUpdatedAt:        types.StringValue(""),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, diags := MapSubgraphFromNative(context.Background(), tt.subgraph)
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestMapFeatureFlagsFromNative(t *testing.T) {
+	result, diags := MapFeatureFlagsFromNative(context.Background(), []*platformv1.FeatureFlag{
+		{Id: "1", Name: "new-checkout", Namespace: "default", IsEnabled: true, Labels: []*platformv1.Label{{Key: "team", Value: "a"}}},
+	})
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []FeatureFlagModel{
+		{
+			Id:        types.StringValue("1"),
+			Name:      types.StringValue("new-checkout"),
+			Namespace: types.StringValue("default"),
+			Labels:    types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("a")}),
+			Enabled:   types.BoolValue(true),
+		},
+	}, result)
+}
@@ -0,0 +1,264 @@
+package datasources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/internal/resources"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FederatedGraphsDataSource{}
+
+func NewFederatedGraphsDataSource() datasource.DataSource {
+	return &FederatedGraphsDataSource{}
+}
+
+// FederatedGraphsDataSource defines the data source implementation.
+type FederatedGraphsDataSource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// FederatedGraphsModel describes the data source data model.
+type FederatedGraphsModel struct {
+	Namespace       types.String               `tfsdk:"namespace"`
+	SubgraphName    types.String               `tfsdk:"subgraph_name"`
+	SubgraphLabels  types.Map                  `tfsdk:"subgraph_labels"`
+	FederatedGraphs []FederatedGraphEntryModel `tfsdk:"federated_graphs"`
+}
+
+// FederatedGraphEntryModel describes a federated graph nested in a data source.
+type FederatedGraphEntryModel struct {
+	Id                 types.String            `tfsdk:"id"`
+	Name               types.String            `tfsdk:"name"`
+	Namespace          types.String            `tfsdk:"namespace"`
+	RoutingUrl         types.String            `tfsdk:"routing_url"`
+	LabelMatchers      resources.LabelMatchers `tfsdk:"label_matchers"`
+	TargetId           types.String            `tfsdk:"target_id"`
+	IsComposable       types.Bool              `tfsdk:"is_composable"`
+	SupportsFederation types.Bool              `tfsdk:"supports_federation"`
+}
+
+func (d *FederatedGraphsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_federated_graphs"
+}
+
+func (d *FederatedGraphsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the federated graphs of the organization, optionally only the graphs that include a subgraph based on its labels.",
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The namespace to list the federated graphs of. When not set, the federated graphs of all namespaces are returned, unless `subgraph_name` is set, in which case it defaults to `default`.",
+				Optional:            true,
+			},
+			"subgraph_name": schema.StringAttribute{
+				MarkdownDescription: "Only return the federated graphs whose label matchers select this existing subgraph. Conflicts with `subgraph_labels`.",
+				Optional:            true,
+			},
+			"subgraph_labels": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return the federated graphs whose label matchers select a subgraph with these labels. This can be used before the subgraph is created. Conflicts with `subgraph_name`.",
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.ConflictsWith(path.MatchRoot("subgraph_name")),
+				},
+			},
+			"federated_graphs": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The id of the federated graph.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the federated graph.",
+						},
+						"namespace": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The namespace of the federated graph.",
+						},
+						"routing_url": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The routing url of the router of the federated graph.",
+						},
+						"label_matchers": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The key of the label matcher.",
+									},
+									"values": schema.ListAttribute{
+										ElementType:         types.StringType,
+										Computed:            true,
+										MarkdownDescription: "The values of the label matcher.",
+									},
+								},
+							},
+							Computed:            true,
+							MarkdownDescription: "The label matchers used to select the subgraphs of the federated graph.",
+						},
+						"target_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The target id of the federated graph, as used by API keys and analytics.",
+						},
+						"is_composable": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the latest composition of the federated graph succeeded.",
+						},
+						"supports_federation": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the graph is a federated graph, as opposed to a monograph.",
+						},
+					},
+				},
+				MarkdownDescription: "The federated graphs.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *FederatedGraphsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// MatchesLabelMatchers reports whether a subgraph with the given labels is selected by the label matchers of a
+// federated graph. Every matcher has to match, and a matcher matches when the subgraph has one of its key=value pairs.
+func MatchesLabelMatchers(matchers []string, labels map[string]string) bool {
+	if len(matchers) == 0 {
+		return false
+	}
+
+	for _, m := range matchers {
+		var matched bool
+		for _, e := range strings.Split(m, ",") {
+			key, value, ok := strings.Cut(e, "=")
+			if v, exists := labels[key]; ok && exists && v == value {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (d *FederatedGraphsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *FederatedGraphsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var graphs []*platformv1.FederatedGraph
+	if !data.SubgraphName.IsNull() {
+		namespace := data.Namespace.ValueString()
+		if namespace == "" {
+			namespace = "default"
+		}
+
+		rl, err := d.client.GetFederatedGraphsBySubgraphLabels(ctx, &connect.Request[platformv1.GetFederatedGraphsBySubgraphLabelsRequest]{
+			Msg: &platformv1.GetFederatedGraphsBySubgraphLabelsRequest{
+				SubgraphName: data.SubgraphName.ValueString(),
+				Namespace:    namespace,
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading federated graphs", err.Error())
+			return
+		}
+
+		if rl.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+			resp.Diagnostics.AddError("Error reading federated graphs", rl.Msg.GetResponse().GetDetails())
+			return
+		}
+
+		graphs = rl.Msg.Graphs
+	} else {
+		// An empty namespace returns the federated graphs of all namespaces.
+		rg, err := d.client.GetFederatedGraphs(ctx, &connect.Request[platformv1.GetFederatedGraphsRequest]{
+			Msg: &platformv1.GetFederatedGraphsRequest{
+				Namespace: data.Namespace.ValueString(),
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading federated graphs", err.Error())
+			return
+		}
+
+		if rg.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+			resp.Diagnostics.AddError("Error reading federated graphs", rg.Msg.GetResponse().GetDetails())
+			return
+		}
+
+		graphs = rg.Msg.Graphs
+	}
+
+	var labels map[string]string
+	if !data.SubgraphLabels.IsNull() {
+		resp.Diagnostics.Append(data.SubgraphLabels.ElementsAs(ctx, &labels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	entries := make([]FederatedGraphEntryModel, 0, len(graphs))
+	for _, g := range graphs {
+		if labels != nil && !MatchesLabelMatchers(g.LabelMatchers, labels) {
+			continue
+		}
+
+		labelMatchers, diags := resources.MapLabelMatchersFromNative(ctx, g.LabelMatchers)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		entries = append(entries, FederatedGraphEntryModel{
+			Id:                 types.StringValue(g.Id),
+			Name:               types.StringValue(g.Name),
+			Namespace:          types.StringValue(g.Namespace),
+			RoutingUrl:         types.StringValue(g.RoutingURL),
+			LabelMatchers:      labelMatchers,
+			TargetId:           types.StringValue(g.TargetId),
+			IsComposable:       types.BoolValue(g.IsComposable),
+			SupportsFederation: types.BoolValue(g.SupportsFederation),
+		})
+	}
+
+	data.FederatedGraphs = entries
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
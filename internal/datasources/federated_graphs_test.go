@@ -0,0 +1,59 @@
+package datasources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchesLabelMatchers(t *testing.T) {
+	tests := []struct {
+		name     string
+		matchers []string
+		labels   map[string]string
+		expected bool
+	}{
+		{
+			name:     "SingleMatcher",
+			matchers: []string{"team=a"},
+			labels:   map[string]string{"team": "a"},
+			expected: true,
+		},
+		{
+			name:     "OneOfValues",
+			matchers: []string{"team=a,team=b"},
+			labels:   map[string]string{"team": "b"},
+			expected: true,
+		},
+		{
+			name:     "AllMatchersRequired",
+			matchers: []string{"team=a", "env=production"},
+			labels:   map[string]string{"team": "a", "env": "staging"},
+			expected: false,
+		},
+		{
+			name:     "AllMatchersMatch",
+			matchers: []string{"team=a", "env=production"},
+			labels:   map[string]string{"team": "a", "env": "production"},
+			expected: true,
+		},
+		{
+			name:     "MissingLabel",
+			matchers: []string{"team="},
+			labels:   map[string]string{},
+			expected: false,
+		},
+		{
+			name:     "NoMatchers",
+			matchers: []string{},
+			labels:   map[string]string{"team": "a"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, MatchesLabelMatchers(tt.matchers, tt.labels))
+		})
+	}
+}
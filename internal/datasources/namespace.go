@@ -0,0 +1,127 @@
+package datasources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NamespaceDataSource{}
+
+func NewNamespaceDataSource() datasource.DataSource {
+	return &NamespaceDataSource{}
+}
+
+// NamespaceDataSource defines the data source implementation.
+type NamespaceDataSource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// NamespaceModel describes the data source data model.
+type NamespaceModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *NamespaceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespace"
+}
+
+func (d *NamespaceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a namespace by its name or id.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the namespace. Conflicts with `name`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the namespace. Conflicts with `id`.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *NamespaceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NamespaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *NamespaceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespaces, err := getNamespaces(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading namespace", err.Error())
+		return
+	}
+
+	var current *NamespaceModel
+	for _, n := range namespaces {
+		if n.Id == data.Id.ValueString() || n.Name == data.Name.ValueString() {
+			current = &NamespaceModel{
+				Id:   types.StringValue(n.Id),
+				Name: types.StringValue(n.Name),
+			}
+			break
+		}
+	}
+
+	if current == nil {
+		resp.Diagnostics.AddError("Error reading namespace", "namespace not found")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &current)...)
+}
+
+// getNamespaces returns all namespaces of the organization.
+func getNamespaces(ctx context.Context, client platformv1connect.PlatformServiceClient) ([]*platformv1.Namespace, error) {
+	rg, err := client.GetNamespaces(ctx, &connect.Request[platformv1.GetNamespacesRequest]{
+		Msg: &platformv1.GetNamespacesRequest{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rg.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		return nil, fmt.Errorf("error fetching namespace list: %s", rg.Msg.GetResponse().GetDetails())
+	}
+
+	return rg.Msg.Namespaces, nil
+}
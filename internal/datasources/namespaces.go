@@ -0,0 +1,126 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+	"regexp"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NamespacesDataSource{}
+
+func NewNamespacesDataSource() datasource.DataSource {
+	return &NamespacesDataSource{}
+}
+
+// NamespacesDataSource defines the data source implementation.
+type NamespacesDataSource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// NamespacesModel describes the data source data model.
+type NamespacesModel struct {
+	NameRegex  types.String     `tfsdk:"name_regex"`
+	Namespaces []NamespaceModel `tfsdk:"namespaces"`
+}
+
+func (d *NamespacesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespaces"
+}
+
+func (d *NamespacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the namespaces of the organization.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "A regular expression the names of the returned namespaces have to match.",
+				Optional:            true,
+			},
+			"namespaces": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The id of the namespace.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the namespace.",
+						},
+					},
+				},
+				MarkdownDescription: "The namespaces, in the order returned by the controlplane.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *NamespacesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// FilterNamespaces returns the namespaces with a name matching the regular expression. All namespaces are returned when the expression is empty.
+func FilterNamespaces(namespaces []*platformv1.Namespace, expr string) ([]NamespaceModel, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	n := make([]NamespaceModel, 0, len(namespaces))
+	for _, v := range namespaces {
+		if re.MatchString(v.Name) {
+			n = append(n, NamespaceModel{
+				Id:   types.StringValue(v.Id),
+				Name: types.StringValue(v.Name),
+			})
+		}
+	}
+
+	return n, nil
+}
+
+func (d *NamespacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *NamespacesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespaces, err := getNamespaces(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading namespaces", err.Error())
+		return
+	}
+
+	filtered, err := FilterNamespaces(namespaces, data.NameRegex.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading namespaces", fmt.Sprintf("invalid name regex: %s", err.Error()))
+		return
+	}
+
+	data.Namespaces = filtered
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,66 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterNamespaces(t *testing.T) {
+	namespaces := []*platformv1.Namespace{
+		{Id: "1", Name: "default"},
+		{Id: "2", Name: "team-a-staging"},
+		{Id: "3", Name: "team-a-production"},
+	}
+
+	tests := []struct {
+		name        string
+		expr        string
+		expected    []NamespaceModel
+		expectError bool
+	}{
+		{
+			name: "EmptyExpression",
+			expr: "",
+			expected: []NamespaceModel{
+				{Id: types.StringValue("1"), Name: types.StringValue("default")},
+				{Id: types.StringValue("2"), Name: types.StringValue("team-a-staging")},
+				{Id: types.StringValue("3"), Name: types.StringValue("team-a-production")},
+			},
+		},
+		{
+			name: "Prefix",
+			expr: "^team-a-",
+			expected: []NamespaceModel{
+				{Id: types.StringValue("2"), Name: types.StringValue("team-a-staging")},
+				{Id: types.StringValue("3"), Name: types.StringValue("team-a-production")},
+			},
+		},
+		{
+			name:     "NoMatch",
+			expr:     "^team-b-",
+			expected: []NamespaceModel{},
+		},
+		{
+			name:        "InvalidExpression",
+			expr:        "team-(",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := FilterNamespaces(namespaces, tt.expr)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,306 @@
+package datasources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+	"sort"
+	"strconv"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RoutersDataSource{}
+
+func NewRoutersDataSource() datasource.DataSource {
+	return &RoutersDataSource{}
+}
+
+// RoutersDataSource defines the data source implementation.
+type RoutersDataSource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// RoutersModel describes the data source data model.
+type RoutersModel struct {
+	FederatedGraphName         types.String  `tfsdk:"federated_graph_name"`
+	Namespace                  types.String  `tfsdk:"namespace"`
+	Routers                    []RouterModel `tfsdk:"routers"`
+	TotalRouters               types.Int64   `tfsdk:"total_routers"`
+	RoutersOnLatestComposition types.Int64   `tfsdk:"routers_on_latest_composition"`
+	ServiceVersions            types.List    `tfsdk:"service_versions"`
+	CompositionIds             types.List    `tfsdk:"composition_ids"`
+	Converged                  types.Bool    `tfsdk:"converged"`
+}
+
+// RouterModel describes a router nested in a data source.
+type RouterModel struct {
+	Hostname                 types.String  `tfsdk:"hostname"`
+	ClusterName              types.String  `tfsdk:"cluster_name"`
+	ServiceName              types.String  `tfsdk:"service_name"`
+	ServiceVersion           types.String  `tfsdk:"service_version"`
+	ServiceInstanceId        types.String  `tfsdk:"service_instance_id"`
+	ProcessId                types.String  `tfsdk:"process_id"`
+	UptimeSeconds            types.String  `tfsdk:"uptime_seconds"`
+	ServerUptimeSeconds      types.String  `tfsdk:"server_uptime_seconds"`
+	CompositionId            types.String  `tfsdk:"composition_id"`
+	OnLatestComposition      types.Bool    `tfsdk:"on_latest_composition"`
+	MemoryUsageMb            types.Float64 `tfsdk:"memory_usage_mb"`
+	MemoryUsageChangePercent types.Float64 `tfsdk:"memory_usage_change_percent"`
+	CpuUsagePercent          types.Float64 `tfsdk:"cpu_usage_percent"`
+	CpuUsageChangePercent    types.Float64 `tfsdk:"cpu_usage_change_percent"`
+}
+
+// RoutersSummary summarizes the state of the routers of a federated graph.
+type RoutersSummary struct {
+	Total               int
+	OnLatestComposition int
+	ServiceVersions     []string
+	CompositionIds      []string
+	Converged           bool
+}
+
+func (d *RoutersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routers"
+}
+
+func (d *RoutersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the routers that recently reported to the control plane for a federated graph, and summarizes whether they all serve the latest composition.",
+		Attributes: map[string]schema.Attribute{
+			"federated_graph_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the federated graph.",
+				Required:            true,
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The namespace of the federated graph. Defaults to `default`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"routers": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"hostname": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The hostname of the router.",
+						},
+						"cluster_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the cluster the router runs in.",
+						},
+						"service_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The service name of the router.",
+						},
+						"service_version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The version of the router.",
+						},
+						"service_instance_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The instance id of the router.",
+						},
+						"process_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The process id of the router.",
+						},
+						"uptime_seconds": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The number of seconds the router process has been running.",
+						},
+						"server_uptime_seconds": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The number of seconds the router has been serving its current configuration.",
+						},
+						"composition_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The id of the composition the router serves.",
+						},
+						"on_latest_composition": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the router serves the latest valid composition.",
+						},
+						"memory_usage_mb": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The memory usage of the router in megabytes.",
+						},
+						"memory_usage_change_percent": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The change of the memory usage of the router, in percent.",
+						},
+						"cpu_usage_percent": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The cpu usage of the router, in percent.",
+						},
+						"cpu_usage_change_percent": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The change of the cpu usage of the router, in percent.",
+						},
+					},
+				},
+				MarkdownDescription: "The routers.",
+				Computed:            true,
+			},
+			"total_routers": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of routers.",
+			},
+			"routers_on_latest_composition": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of routers that serve the latest valid composition.",
+			},
+			"service_versions": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "The distinct versions of the routers, sorted.",
+			},
+			"composition_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "The distinct ids of the compositions served by the routers, sorted.",
+			},
+			"converged": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether there is at least one router and all routers serve the latest valid composition.",
+			},
+		},
+	}
+}
+
+func (d *RoutersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// SummarizeRouters counts the routers on the latest composition and collects the distinct service versions and
+// composition ids of the routers.
+func SummarizeRouters(routers []*platformv1.Router) RoutersSummary {
+	versions := map[string]struct{}{}
+	compositions := map[string]struct{}{}
+	summary := RoutersSummary{
+		Total:           len(routers),
+		ServiceVersions: []string{},
+		CompositionIds:  []string{},
+	}
+
+	for _, r := range routers {
+		if r.OnLatestComposition {
+			summary.OnLatestComposition++
+		}
+
+		if _, ok := versions[r.ServiceVersion]; !ok && r.ServiceVersion != "" {
+			versions[r.ServiceVersion] = struct{}{}
+			summary.ServiceVersions = append(summary.ServiceVersions, r.ServiceVersion)
+		}
+
+		if _, ok := compositions[r.CompositionId]; !ok && r.CompositionId != "" {
+			compositions[r.CompositionId] = struct{}{}
+			summary.CompositionIds = append(summary.CompositionIds, r.CompositionId)
+		}
+	}
+
+	sort.Strings(summary.ServiceVersions)
+	sort.Strings(summary.CompositionIds)
+	summary.Converged = summary.Total > 0 && summary.OnLatestComposition == summary.Total
+
+	return summary
+}
+
+// float32Value converts a float32 without exposing its binary rounding error, e.g. 0.1 instead of 0.10000000149.
+func float32Value(f float32) types.Float64 {
+	v, _ := strconv.ParseFloat(strconv.FormatFloat(float64(f), 'f', -1, 32), 64)
+	return types.Float64Value(v)
+}
+
+// MapRouterFromNative maps a router to its data source model.
+func MapRouterFromNative(r *platformv1.Router) RouterModel {
+	return RouterModel{
+		Hostname:                 types.StringValue(r.Hostname),
+		ClusterName:              types.StringValue(r.ClusterName),
+		ServiceName:              types.StringValue(r.ServiceName),
+		ServiceVersion:           types.StringValue(r.ServiceVersion),
+		ServiceInstanceId:        types.StringValue(r.ServiceInstanceId),
+		ProcessId:                types.StringValue(r.ProcessId),
+		UptimeSeconds:            types.StringValue(r.UptimeSeconds),
+		ServerUptimeSeconds:      types.StringValue(r.ServerUptimeSeconds),
+		CompositionId:            types.StringValue(r.CompositionId),
+		OnLatestComposition:      types.BoolValue(r.OnLatestComposition),
+		MemoryUsageMb:            float32Value(r.MemoryUsageMb),
+		MemoryUsageChangePercent: float32Value(r.MemoryUsageChangePercent),
+		CpuUsagePercent:          float32Value(r.CpuUsagePercent),
+		CpuUsageChangePercent:    float32Value(r.CpuUsageChangePercent),
+	}
+}
+
+func (d *RoutersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *RoutersModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Namespace.IsNull() {
+		data.Namespace = types.StringValue("default")
+	}
+
+	rr, err := d.client.GetRouters(ctx, &connect.Request[platformv1.GetRoutersRequest]{
+		Msg: &platformv1.GetRoutersRequest{
+			FedGraphName: data.FederatedGraphName.ValueString(),
+			Namespace:    data.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading routers", err.Error())
+		return
+	}
+
+	if rr.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error reading routers", rr.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	routers := make([]RouterModel, 0, len(rr.Msg.Routers))
+	for _, r := range rr.Msg.Routers {
+		routers = append(routers, MapRouterFromNative(r))
+	}
+
+	summary := SummarizeRouters(rr.Msg.Routers)
+
+	data.Routers = routers
+	data.TotalRouters = types.Int64Value(int64(summary.Total))
+	data.RoutersOnLatestComposition = types.Int64Value(int64(summary.OnLatestComposition))
+	data.Converged = types.BoolValue(summary.Converged)
+
+	var diags diag.Diagnostics
+	data.ServiceVersions, diags = types.ListValueFrom(ctx, types.StringType, summary.ServiceVersions)
+	resp.Diagnostics.Append(diags...)
+
+	data.CompositionIds, diags = types.ListValueFrom(ctx, types.StringType, summary.CompositionIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
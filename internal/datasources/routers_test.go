@@ -0,0 +1,76 @@
+package datasources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeRouters(t *testing.T) {
+	tests := []struct {
+		name     string
+		routers  []*platformv1.Router
+		expected RoutersSummary
+	}{
+		{
+			name:    "NoRouters",
+			routers: nil,
+			expected: RoutersSummary{
+				ServiceVersions: []string{},
+				CompositionIds:  []string{},
+			},
+		},
+		{
+			name: "Converged",
+			routers: []*platformv1.Router{
+				{ServiceVersion: "0.100.0", CompositionId: "c2", OnLatestComposition: true},
+				{ServiceVersion: "0.100.0", CompositionId: "c2", OnLatestComposition: true},
+			},
+			expected: RoutersSummary{
+				Total:               2,
+				OnLatestComposition: 2,
+				ServiceVersions:     []string{"0.100.0"},
+				CompositionIds:      []string{"c2"},
+				Converged:           true,
+			},
+		},
+		{
+			name: "RollingOut",
+			routers: []*platformv1.Router{
+				{ServiceVersion: "0.101.0", CompositionId: "c2", OnLatestComposition: true},
+				{ServiceVersion: "0.100.0", CompositionId: "c1"},
+				{ServiceVersion: "", CompositionId: ""},
+			},
+			expected: RoutersSummary{
+				Total:               3,
+				OnLatestComposition: 1,
+				ServiceVersions:     []string{"0.100.0", "0.101.0"},
+				CompositionIds:      []string{"c1", "c2"},
+				Converged:           false,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, SummarizeRouters(tt.routers))
+		})
+	}
+}
+
+func TestMapRouterFromNative(t *testing.T) {
+	result := MapRouterFromNative(&platformv1.Router{
+		Hostname:        "router-1",
+		CompositionId:   "c1",
+		MemoryUsageMb:   64.1,
+		CpuUsagePercent: 0.1,
+	})
+
+	assert.Equal(t, types.StringValue("router-1"), result.Hostname)
+	assert.Equal(t, types.StringValue("c1"), result.CompositionId)
+	assert.Equal(t, types.Float64Value(64.1), result.MemoryUsageMb)
+	assert.Equal(t, types.Float64Value(0.1), result.CpuUsagePercent)
+	assert.Equal(t, types.Float64Value(0), result.CpuUsageChangePercent)
+}
@@ -0,0 +1,383 @@
+package datasources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+	"strings"
+	"time"
+)
+
+// changelogPageSize is the number of schema versions fetched per request.
+const changelogPageSize = 10
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SchemaChangelogDataSource{}
+
+func NewSchemaChangelogDataSource() datasource.DataSource {
+	return &SchemaChangelogDataSource{}
+}
+
+// SchemaChangelogDataSource defines the data source implementation.
+type SchemaChangelogDataSource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// SchemaChangelogModel describes the data source data model.
+type SchemaChangelogModel struct {
+	FederatedGraphName types.String                `tfsdk:"federated_graph_name"`
+	Namespace          types.String                `tfsdk:"namespace"`
+	SchemaVersionId    types.String                `tfsdk:"schema_version_id"`
+	StartDate          types.String                `tfsdk:"start_date"`
+	EndDate            types.String                `tfsdk:"end_date"`
+	Limit              types.Int64                 `tfsdk:"limit"`
+	IncludeMarkdown    types.Bool                  `tfsdk:"include_markdown"`
+	SchemaVersions     []SchemaVersionChangesModel `tfsdk:"schema_versions"`
+	Markdown           types.String                `tfsdk:"markdown"`
+}
+
+// SchemaVersionChangesModel describes the changes of a schema version nested in a data source.
+type SchemaVersionChangesModel struct {
+	SchemaVersionId types.String        `tfsdk:"schema_version_id"`
+	CompositionId   types.String        `tfsdk:"composition_id"`
+	CreatedAt       types.String        `tfsdk:"created_at"`
+	AddedPaths      types.List          `tfsdk:"added_paths"`
+	RemovedPaths    types.List          `tfsdk:"removed_paths"`
+	ChangedPaths    types.List          `tfsdk:"changed_paths"`
+	Changes         []SchemaChangeModel `tfsdk:"changes"`
+}
+
+// SchemaChangeModel describes a single change of a schema version.
+type SchemaChangeModel struct {
+	Id            types.String `tfsdk:"id"`
+	Path          types.String `tfsdk:"path"`
+	ChangeType    types.String `tfsdk:"change_type"`
+	ChangeMessage types.String `tfsdk:"change_message"`
+}
+
+func (d *SchemaChangelogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_changelog"
+}
+
+func (d *SchemaChangelogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the changes of the composed schema of a federated graph per schema version, newest first, either within a date range or for a single schema version.",
+		Attributes: map[string]schema.Attribute{
+			"federated_graph_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the federated graph to list the changelog of. Exactly one of `federated_graph_name` and `schema_version_id` must be set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("schema_version_id")),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The namespace of the federated graph. Defaults to `default`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"schema_version_id": schema.StringAttribute{
+				MarkdownDescription: "The id of a single schema version to return the changes of, e.g. the `schema_version_id` of a composition.",
+				Optional:            true,
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "The start of the date range, in RFC 3339 format. Defaults to 7 days before `end_date`. Only used with `federated_graph_name`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"end_date": schema.StringAttribute{
+				MarkdownDescription: "The end of the date range, in RFC 3339 format. Defaults to the current time. Only used with `federated_graph_name`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of schema versions to return. When not set, all schema versions within the date range are returned.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"include_markdown": schema.BoolAttribute{
+				MarkdownDescription: "Render the changes as markdown in `markdown`.",
+				Optional:            true,
+			},
+			"schema_versions": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"schema_version_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The id of the schema version.",
+						},
+						"composition_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The id of the composition that produced the schema version.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The time the schema version was created.",
+						},
+						"added_paths": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "The paths that were added to the schema.",
+						},
+						"removed_paths": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "The paths that were removed from the schema.",
+						},
+						"changed_paths": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "The paths that were otherwise changed, e.g. a changed type or description.",
+						},
+						"changes": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The id of the change.",
+									},
+									"path": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The path in the schema that changed.",
+									},
+									"change_type": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The type of the change, e.g. `FIELD_ADDED`.",
+									},
+									"change_message": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "A description of the change.",
+									},
+								},
+							},
+							Computed:            true,
+							MarkdownDescription: "All changes of the schema version.",
+						},
+					},
+				},
+				MarkdownDescription: "The schema versions and their changes.",
+				Computed:            true,
+			},
+			"markdown": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The changes rendered as markdown, when `include_markdown` is set.",
+			},
+		},
+	}
+}
+
+func (d *SchemaChangelogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ClassifyChangeType returns whether a change type adds, removes or otherwise changes a path in the schema.
+func ClassifyChangeType(changeType string) string {
+	switch {
+	case strings.HasSuffix(changeType, "_ADDED"):
+		return "added"
+	case strings.HasSuffix(changeType, "_REMOVED"):
+		return "removed"
+	default:
+		return "changed"
+	}
+}
+
+// MapSchemaVersionChangesFromNative maps the changelog of a schema version to its data source model.
+func MapSchemaVersionChangesFromNative(ctx context.Context, c *platformv1.FederatedGraphChangelogOutput) (SchemaVersionChangesModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	paths := map[string][]string{"added": {}, "removed": {}, "changed": {}}
+	changes := make([]SchemaChangeModel, 0, len(c.Changelogs))
+	for _, v := range c.Changelogs {
+		kind := ClassifyChangeType(v.ChangeType)
+		paths[kind] = append(paths[kind], v.Path)
+
+		changes = append(changes, SchemaChangeModel{
+			Id:            types.StringValue(v.Id),
+			Path:          types.StringValue(v.Path),
+			ChangeType:    types.StringValue(v.ChangeType),
+			ChangeMessage: types.StringValue(v.ChangeMessage),
+		})
+	}
+
+	added, d := types.ListValueFrom(ctx, types.StringType, paths["added"])
+	diags.Append(d...)
+	removed, d := types.ListValueFrom(ctx, types.StringType, paths["removed"])
+	diags.Append(d...)
+	changed, d := types.ListValueFrom(ctx, types.StringType, paths["changed"])
+	diags.Append(d...)
+
+	return SchemaVersionChangesModel{
+		SchemaVersionId: types.StringValue(c.SchemaVersionId),
+		CompositionId:   types.StringPointerValue(nonEmpty(&c.CompositionId)),
+		CreatedAt:       types.StringValue(c.CreatedAt),
+		AddedPaths:      added,
+		RemovedPaths:    removed,
+		ChangedPaths:    changed,
+		Changes:         changes,
+	}, diags
+}
+
+// RenderChangelogMarkdown renders the changes of the schema versions as markdown, with a section per schema version
+// and the changes grouped by whether they add, remove or change a path.
+func RenderChangelogMarkdown(changelogs []*platformv1.FederatedGraphChangelogOutput) string {
+	var b strings.Builder
+	for i, c := range changelogs {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		fmt.Fprintf(&b, "## %s\n\nSchema version `%s`\n", c.CreatedAt, c.SchemaVersionId)
+
+		for _, group := range []struct{ kind, title string }{
+			{"added", "Added"},
+			{"removed", "Removed"},
+			{"changed", "Changed"},
+		} {
+			var lines []string
+			for _, v := range c.Changelogs {
+				if ClassifyChangeType(v.ChangeType) == group.kind {
+					lines = append(lines, fmt.Sprintf("- `%s`: %s\n", v.Path, v.ChangeMessage))
+				}
+			}
+
+			if len(lines) == 0 {
+				continue
+			}
+
+			fmt.Fprintf(&b, "\n### %s\n\n%s", group.title, strings.Join(lines, ""))
+		}
+	}
+
+	return b.String()
+}
+
+func (d *SchemaChangelogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *SchemaChangelogModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Namespace.IsNull() {
+		data.Namespace = types.StringValue("default")
+	}
+
+	var changelogs []*platformv1.FederatedGraphChangelogOutput
+	if !data.SchemaVersionId.IsNull() {
+		rv, err := d.client.GetChangelogBySchemaVersion(ctx, &connect.Request[platformv1.GetChangelogBySchemaVersionRequest]{
+			Msg: &platformv1.GetChangelogBySchemaVersionRequest{
+				SchemaVersionId: data.SchemaVersionId.ValueString(),
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading schema changelog", err.Error())
+			return
+		}
+
+		if rv.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+			resp.Diagnostics.AddError("Error reading schema changelog", rv.Msg.GetResponse().GetDetails())
+			return
+		}
+
+		if rv.Msg.Changelog != nil {
+			changelogs = append(changelogs, rv.Msg.Changelog)
+		}
+	} else {
+		startDate, endDate, err := ResolveDateRange(data.StartDate.ValueString(), data.EndDate.ValueString(), time.Now())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid date range", err.Error())
+			return
+		}
+
+		data.StartDate = types.StringValue(startDate)
+		data.EndDate = types.StringValue(endDate)
+
+		for offset := int32(0); ; offset += changelogPageSize {
+			rc, err := d.client.GetFederatedGraphChangelog(ctx, &connect.Request[platformv1.GetFederatedGraphChangelogRequest]{
+				Msg: &platformv1.GetFederatedGraphChangelogRequest{
+					Name:      data.FederatedGraphName.ValueString(),
+					Namespace: data.Namespace.ValueString(),
+					Pagination: &platformv1.Pagination{
+						Limit:  changelogPageSize,
+						Offset: offset,
+					},
+					DateRange: &platformv1.DateRange{
+						Start: startDate,
+						End:   endDate,
+					},
+				},
+			})
+			if err != nil {
+				resp.Diagnostics.AddError("Error reading schema changelog", err.Error())
+				return
+			}
+
+			if rc.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+				resp.Diagnostics.AddError("Error reading schema changelog", rc.Msg.GetResponse().GetDetails())
+				return
+			}
+
+			changelogs = append(changelogs, rc.Msg.FederatedGraphChangelogOutput...)
+
+			if !rc.Msg.HasNextPage || len(rc.Msg.FederatedGraphChangelogOutput) == 0 {
+				break
+			}
+
+			if !data.Limit.IsNull() && int64(len(changelogs)) >= data.Limit.ValueInt64() {
+				break
+			}
+		}
+	}
+
+	if !data.Limit.IsNull() && int64(len(changelogs)) > data.Limit.ValueInt64() {
+		changelogs = changelogs[:data.Limit.ValueInt64()]
+	}
+
+	versions := make([]SchemaVersionChangesModel, 0, len(changelogs))
+	for _, c := range changelogs {
+		v, diags := MapSchemaVersionChangesFromNative(ctx, c)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		versions = append(versions, v)
+	}
+
+	data.SchemaVersions = versions
+	data.Markdown = types.StringNull()
+	if data.IncludeMarkdown.ValueBool() {
+		data.Markdown = types.StringValue(RenderChangelogMarkdown(changelogs))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,73 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyChangeType(t *testing.T) {
+	tests := []struct {
+		changeType string
+		expected   string
+	}{
+		{changeType: "FIELD_ADDED", expected: "added"},
+		{changeType: "TYPE_REMOVED", expected: "removed"},
+		{changeType: "FIELD_TYPE_CHANGED", expected: "changed"},
+		{changeType: "FIELD_DESCRIPTION_CHANGED", expected: "changed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.changeType, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ClassifyChangeType(tt.changeType))
+		})
+	}
+}
+
+var testChangelog = &platformv1.FederatedGraphChangelogOutput{
+	CreatedAt:       "2024-07-29T12:00:00.000Z",
+	SchemaVersionId: "v2",
+	CompositionId:   "c2",
+	Changelogs: []*platformv1.FederatedGraphChangelog{
+		{Id: "1", Path: "Product.price", ChangeType: "FIELD_ADDED", ChangeMessage: "Field 'price' was added to object type 'Product'"},
+		{Id: "2", Path: "Product.cost", ChangeType: "FIELD_REMOVED", ChangeMessage: "Field 'cost' was removed from object type 'Product'"},
+		{Id: "3", Path: "Query.products", ChangeType: "FIELD_TYPE_CHANGED", ChangeMessage: "Field 'Query.products' changed type from '[Product]' to '[Product!]!'"},
+	},
+}
+
+func TestMapSchemaVersionChangesFromNative(t *testing.T) {
+	result, diags := MapSchemaVersionChangesFromNative(context.Background(), testChangelog)
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, types.StringValue("v2"), result.SchemaVersionId)
+	assert.Equal(t, types.StringValue("c2"), result.CompositionId)
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Product.price")}), result.AddedPaths)
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Product.cost")}), result.RemovedPaths)
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Query.products")}), result.ChangedPaths)
+	assert.Len(t, result.Changes, 3)
+}
+
+func TestRenderChangelogMarkdown(t *testing.T) {
+	expected := "## 2024-07-29T12:00:00.000Z\n\n" +
+		"Schema version `v2`\n\n" +
+		"### Added\n\n" +
+		"- `Product.price`: Field 'price' was added to object type 'Product'\n\n" +
+		"### Removed\n\n" +
+		"- `Product.cost`: Field 'cost' was removed from object type 'Product'\n\n" +
+		"### Changed\n\n" +
+		"- `Query.products`: Field 'Query.products' changed type from '[Product]' to '[Product!]!'\n" +
+		"\n" +
+		"## 2024-07-28T12:00:00.000Z\n\n" +
+		"Schema version `v1`\n"
+
+	result := RenderChangelogMarkdown([]*platformv1.FederatedGraphChangelogOutput{
+		testChangelog,
+		{CreatedAt: "2024-07-28T12:00:00.000Z", SchemaVersionId: "v1"},
+	})
+
+	assert.Equal(t, expected, result)
+}
@@ -0,0 +1,318 @@
+package datasources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SubgraphDataSource{}
+
+func NewSubgraphDataSource() datasource.DataSource {
+	return &SubgraphDataSource{}
+}
+
+// SubgraphDataSource defines the data source implementation.
+type SubgraphDataSource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// SubgraphDataSourceModel describes the data source data model.
+type SubgraphDataSourceModel struct {
+	Id                      types.String          `tfsdk:"id"`
+	Name                    types.String          `tfsdk:"name"`
+	Namespace               types.String          `tfsdk:"namespace"`
+	FederatedGraphName      types.String          `tfsdk:"federated_graph_name"`
+	RoutingUrl              types.String          `tfsdk:"routing_url"`
+	SubscriptionUrl         types.String          `tfsdk:"subscription_url"`
+	SubscriptionProtocol    types.String          `tfsdk:"subscription_protocol"`
+	WebsocketSubprotocol    types.String          `tfsdk:"websocket_subprotocol"`
+	Labels                  types.Map             `tfsdk:"labels"`
+	TargetId                types.String          `tfsdk:"target_id"`
+	Readme                  types.String          `tfsdk:"readme"`
+	IsEventDrivenGraph      types.Bool            `tfsdk:"is_event_driven_graph"`
+	IsFeatureSubgraph       types.Bool            `tfsdk:"is_feature_subgraph"`
+	BaseSubgraphName        types.String          `tfsdk:"base_subgraph_name"`
+	BaseSubgraphId          types.String          `tfsdk:"base_subgraph_id"`
+	LastUpdatedAt           types.String          `tfsdk:"last_updated_at"`
+	Members                 []SubgraphMemberModel `tfsdk:"members"`
+	Schema                  types.String          `tfsdk:"schema"`
+	SchemaVersionId         types.String          `tfsdk:"schema_version_id"`
+	ComposedSchema          types.String          `tfsdk:"composed_schema"`
+	ComposedSchemaVersionId types.String          `tfsdk:"composed_schema_version_id"`
+}
+
+// SubgraphMemberModel describes a member of a subgraph nested in a data source.
+type SubgraphMemberModel struct {
+	Id     types.String `tfsdk:"id"`
+	UserId types.String `tfsdk:"user_id"`
+	Email  types.String `tfsdk:"email"`
+}
+
+func (d *SubgraphDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subgraph"
+}
+
+func (d *SubgraphDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a subgraph by its name, including its published schema and the schema that is part of the latest composition of a federated graph.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the subgraph.",
+				Required:            true,
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The namespace of the subgraph. Defaults to `default`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"federated_graph_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the federated graph to fetch the composed schema of the subgraph from. When not set, `composed_schema` is not populated.",
+				Optional:            true,
+			},
+			"routing_url": schema.StringAttribute{
+				MarkdownDescription: "The routing url of the subgraph.",
+				Computed:            true,
+			},
+			"subscription_url": schema.StringAttribute{
+				MarkdownDescription: "The url used for subscriptions, if it differs from the routing url.",
+				Computed:            true,
+			},
+			"subscription_protocol": schema.StringAttribute{
+				MarkdownDescription: "The protocol used for subscriptions.",
+				Computed:            true,
+			},
+			"websocket_subprotocol": schema.StringAttribute{
+				MarkdownDescription: "The subprotocol used for subscriptions over websockets.",
+				Computed:            true,
+			},
+			"labels": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The labels of the subgraph.",
+				Computed:            true,
+			},
+			"target_id": schema.StringAttribute{
+				MarkdownDescription: "The target id of the subgraph, as used by API keys and analytics.",
+				Computed:            true,
+			},
+			"readme": schema.StringAttribute{
+				MarkdownDescription: "The markdown text which describes the subgraph.",
+				Computed:            true,
+			},
+			"is_event_driven_graph": schema.BoolAttribute{
+				MarkdownDescription: "Whether the subgraph is an Event-Driven Graph (EDG).",
+				Computed:            true,
+			},
+			"is_feature_subgraph": schema.BoolAttribute{
+				MarkdownDescription: "Whether the subgraph is a feature subgraph.",
+				Computed:            true,
+			},
+			"base_subgraph_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the base subgraph of a feature subgraph.",
+				Computed:            true,
+			},
+			"base_subgraph_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the base subgraph of a feature subgraph.",
+				Computed:            true,
+			},
+			"last_updated_at": schema.StringAttribute{
+				MarkdownDescription: "The time the subgraph was last updated.",
+				Computed:            true,
+			},
+			"members": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The id of the subgraph member.",
+						},
+						"user_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The id of the user.",
+						},
+						"email": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The email address of the user.",
+						},
+					},
+				},
+				MarkdownDescription: "The members of the subgraph, who are allowed to publish it when RBAC is enabled.",
+				Computed:            true,
+			},
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "The latest published schema of the subgraph.",
+				Computed:            true,
+			},
+			"schema_version_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the schema version of the latest published schema.",
+				Computed:            true,
+			},
+			"composed_schema": schema.StringAttribute{
+				MarkdownDescription: "The schema of the subgraph that is part of the latest composition of `federated_graph_name`.",
+				Computed:            true,
+			},
+			"composed_schema_version_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the schema version of `composed_schema`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SubgraphDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func MapSubgraphMembersFromNative(members []*platformv1.SubgraphMember) []SubgraphMemberModel {
+	m := make([]SubgraphMemberModel, 0, len(members))
+	for _, v := range members {
+		m = append(m, SubgraphMemberModel{
+			Id:     types.StringValue(v.SubgraphMemberId),
+			UserId: types.StringValue(v.UserId),
+			Email:  types.StringValue(v.Email),
+		})
+	}
+
+	return m
+}
+
+// MapSubgraphDetailsFromNative sets the attributes of the subgraph and its members on the data source model.
+func MapSubgraphDetailsFromNative(ctx context.Context, data *SubgraphDataSourceModel, graph *platformv1.Subgraph, members []*platformv1.SubgraphMember) diag.Diagnostics {
+	subgraph, diags := MapSubgraphFromNative(ctx, graph)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Id = subgraph.Id
+	data.Namespace = subgraph.Namespace
+	data.RoutingUrl = subgraph.RoutingUrl
+	data.SubscriptionUrl = subgraph.SubscriptionUrl
+	data.SubscriptionProtocol = subgraph.SubscriptionProtocol
+	data.WebsocketSubprotocol = subgraph.WebsocketSubprotocol
+	data.Labels = subgraph.Labels
+	data.TargetId = subgraph.TargetId
+	data.Readme = types.StringPointerValue(nonEmpty(graph.Readme))
+	data.IsEventDrivenGraph = subgraph.IsEventDrivenGraph
+	data.IsFeatureSubgraph = subgraph.IsFeatureSubgraph
+	data.BaseSubgraphName = subgraph.BaseSubgraphName
+	data.BaseSubgraphId = types.StringPointerValue(nonEmpty(graph.BaseSubgraphId))
+	data.LastUpdatedAt = subgraph.LastUpdatedAt
+	data.Members = MapSubgraphMembersFromNative(members)
+
+	return diags
+}
+
+func (d *SubgraphDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *SubgraphDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Namespace.IsNull() {
+		data.Namespace = types.StringValue("default")
+	}
+
+	rg, err := d.client.GetSubgraphByName(ctx, &connect.Request[platformv1.GetSubgraphByNameRequest]{
+		Msg: &platformv1.GetSubgraphByNameRequest{
+			Name:      data.Name.ValueString(),
+			Namespace: data.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading subgraph", err.Error())
+		return
+	}
+
+	if rg.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error reading subgraph", rg.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	resp.Diagnostics.Append(MapSubgraphDetailsFromNative(ctx, data, rg.Msg.Graph, rg.Msg.Members)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rs, err := d.client.GetLatestSubgraphSDL(ctx, &connect.Request[platformv1.GetLatestSubgraphSDLRequest]{
+		Msg: &platformv1.GetLatestSubgraphSDLRequest{
+			Name:      data.Name.ValueString(),
+			Namespace: data.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching SDL", err.Error())
+		return
+	}
+
+	// A subgraph that has not been published yet has no schema.
+	switch rs.Msg.GetResponse().Code {
+	case common.EnumStatusCode_OK:
+		data.Schema = types.StringPointerValue(rs.Msg.Sdl)
+		data.SchemaVersionId = types.StringPointerValue(rs.Msg.VersionId)
+	case common.EnumStatusCode_ERR_NOT_FOUND:
+		data.Schema = types.StringNull()
+		data.SchemaVersionId = types.StringNull()
+	default:
+		resp.Diagnostics.AddError("Error fetching SDL", rs.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	data.ComposedSchema = types.StringNull()
+	data.ComposedSchemaVersionId = types.StringNull()
+
+	if !data.FederatedGraphName.IsNull() {
+		rc, err := d.client.GetSubgraphSDLFromLatestComposition(ctx, &connect.Request[platformv1.GetSubgraphSDLFromLatestCompositionRequest]{
+			Msg: &platformv1.GetSubgraphSDLFromLatestCompositionRequest{
+				Name:         data.Name.ValueString(),
+				FedGraphName: data.FederatedGraphName.ValueString(),
+				Namespace:    data.Namespace.ValueString(),
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching composed SDL", err.Error())
+			return
+		}
+
+		// The subgraph is not part of a valid composition of the federated graph yet.
+		switch rc.Msg.GetResponse().Code {
+		case common.EnumStatusCode_OK:
+			data.ComposedSchema = types.StringPointerValue(rc.Msg.Sdl)
+			data.ComposedSchemaVersionId = types.StringPointerValue(rc.Msg.VersionId)
+		case common.EnumStatusCode_ERR_NOT_FOUND:
+		default:
+			resp.Diagnostics.AddError("Error fetching composed SDL", rc.Msg.GetResponse().GetDetails())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
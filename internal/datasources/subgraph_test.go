@@ -0,0 +1,79 @@
+package datasources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapSubgraphMembersFromNative(t *testing.T) {
+	result := MapSubgraphMembersFromNative([]*platformv1.SubgraphMember{
+		{SubgraphMemberId: "m1", UserId: "u1", Email: "a@example.com"},
+		{SubgraphMemberId: "m2", UserId: "u2", Email: "b@example.com"},
+	})
+
+	assert.Equal(t, []SubgraphMemberModel{
+		{Id: types.StringValue("m1"), UserId: types.StringValue("u1"), Email: types.StringValue("a@example.com")},
+		{Id: types.StringValue("m2"), UserId: types.StringValue("u2"), Email: types.StringValue("b@example.com")},
+	}, result)
+
+	assert.Equal(t, []SubgraphMemberModel{}, MapSubgraphMembersFromNative(nil))
+}
+
+func TestMapSubgraphDetailsFromNative(t *testing.T) {
+	empty := ""
+	readme := "# Products"
+	base := "products"
+	baseId := "1"
+
+	tests := []struct {
+		name                   string
+		subgraph               *platformv1.Subgraph
+		expectedReadme         types.String
+		expectedBaseSubgraphId types.String
+	}{
+		{
+			name:                   "Unset",
+			subgraph:               &platformv1.Subgraph{Id: "1", Name: "products"},
+			expectedReadme:         types.StringNull(),
+			expectedBaseSubgraphId: types.StringNull(),
+		},
+		{
+			name:                   "Empty",
+			subgraph:               &platformv1.Subgraph{Id: "1", Name: "products", Readme: &empty, BaseSubgraphId: &empty},
+			expectedReadme:         types.StringNull(),
+			expectedBaseSubgraphId: types.StringNull(),
+		},
+		{
+			name: "FeatureSubgraph",
+			subgraph: &platformv1.Subgraph{
+				Id:                "2",
+				Name:              "products-v2",
+				Readme:            &readme,
+				IsFeatureSubgraph: true,
+				BaseSubgraphName:  &base,
+				BaseSubgraphId:    &baseId,
+			},
+			expectedReadme:         types.StringValue("# Products"),
+			expectedBaseSubgraphId: types.StringValue("1"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data SubgraphDataSourceModel
+			diags := MapSubgraphDetailsFromNative(context.Background(), &data, tt.subgraph, []*platformv1.SubgraphMember{
+				{SubgraphMemberId: "m1", UserId: "u1", Email: "a@example.com"},
+			})
+
+			assert.False(t, diags.HasError())
+			assert.Equal(t, types.StringValue(tt.subgraph.Id), data.Id)
+			assert.Equal(t, tt.expectedReadme, data.Readme)
+			assert.Equal(t, tt.expectedBaseSubgraphId, data.BaseSubgraphId)
+			assert.Len(t, data.Members, 1)
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package datasources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SubgraphsDataSource{}
+
+func NewSubgraphsDataSource() datasource.DataSource {
+	return &SubgraphsDataSource{}
+}
+
+// SubgraphsDataSource defines the data source implementation.
+type SubgraphsDataSource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// SubgraphsModel describes the data source data model.
+type SubgraphsModel struct {
+	Namespace          types.String    `tfsdk:"namespace"`
+	Labels             types.Map       `tfsdk:"labels"`
+	IsEventDrivenGraph types.Bool      `tfsdk:"is_event_driven_graph"`
+	IsFeatureSubgraph  types.Bool      `tfsdk:"is_feature_subgraph"`
+	FeatureFlagName    types.String    `tfsdk:"feature_flag_name"`
+	Subgraphs          []SubgraphModel `tfsdk:"subgraphs"`
+}
+
+// SubgraphFilter describes the conditions a subgraph has to meet to be returned. Nil conditions are ignored.
+type SubgraphFilter struct {
+	Labels             map[string]string
+	IsEventDrivenGraph *bool
+	IsFeatureSubgraph  *bool
+}
+
+func (d *SubgraphsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subgraphs"
+}
+
+func (d *SubgraphsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the subgraphs of a namespace.",
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The namespace to list the subgraphs of. Defaults to `default`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"labels": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return the subgraphs that have all of these labels.",
+				Optional:            true,
+			},
+			"is_event_driven_graph": schema.BoolAttribute{
+				MarkdownDescription: "Only return the subgraphs that are, or are not, an Event-Driven Graph (EDG).",
+				Optional:            true,
+			},
+			"is_feature_subgraph": schema.BoolAttribute{
+				MarkdownDescription: "Only return the subgraphs that are, or are not, a feature subgraph.",
+				Optional:            true,
+			},
+			"feature_flag_name": schema.StringAttribute{
+				MarkdownDescription: "Only return the feature subgraphs of this feature flag.",
+				Optional:            true,
+			},
+			"subgraphs": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: subgraphAttributes(),
+				},
+				MarkdownDescription: "The subgraphs.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SubgraphsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// FilterSubgraphs returns the subgraphs matching all conditions of the filter.
+func FilterSubgraphs(subgraphs []*platformv1.Subgraph, filter SubgraphFilter) []*platformv1.Subgraph {
+	var s []*platformv1.Subgraph
+	for _, v := range subgraphs {
+		if filter.IsEventDrivenGraph != nil && v.IsEventDrivenGraph != *filter.IsEventDrivenGraph {
+			continue
+		}
+
+		if filter.IsFeatureSubgraph != nil && v.IsFeatureSubgraph != *filter.IsFeatureSubgraph {
+			continue
+		}
+
+		if !hasLabels(v.Labels, filter.Labels) {
+			continue
+		}
+
+		s = append(s, v)
+	}
+
+	return s
+}
+
+func hasLabels(labels []*platformv1.Label, required map[string]string) bool {
+	for key, value := range required {
+		var found bool
+		for _, l := range labels {
+			if l.Key == key && l.Value == value {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (d *SubgraphsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *SubgraphsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Namespace.IsNull() {
+		data.Namespace = types.StringValue("default")
+	}
+
+	var subgraphs []*platformv1.Subgraph
+	if !data.FeatureFlagName.IsNull() {
+		rf, err := d.client.GetFeatureSubgraphsByFeatureFlag(ctx, &connect.Request[platformv1.GetFeatureSubgraphsByFeatureFlagRequest]{
+			Msg: &platformv1.GetFeatureSubgraphsByFeatureFlagRequest{
+				FeatureFlagName: data.FeatureFlagName.ValueString(),
+				Namespace:       data.Namespace.ValueString(),
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading subgraphs", err.Error())
+			return
+		}
+
+		if rf.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+			resp.Diagnostics.AddError("Error reading subgraphs", rf.Msg.GetResponse().GetDetails())
+			return
+		}
+
+		subgraphs = rf.Msg.FeatureSubgraphs
+	} else {
+		rg, err := d.client.GetSubgraphs(ctx, &connect.Request[platformv1.GetSubgraphsRequest]{
+			Msg: &platformv1.GetSubgraphsRequest{
+				Namespace: data.Namespace.ValueString(),
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading subgraphs", err.Error())
+			return
+		}
+
+		if rg.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+			resp.Diagnostics.AddError("Error reading subgraphs", rg.Msg.GetResponse().GetDetails())
+			return
+		}
+
+		subgraphs = rg.Msg.Graphs
+	}
+
+	filter := SubgraphFilter{
+		IsEventDrivenGraph: data.IsEventDrivenGraph.ValueBoolPointer(),
+		IsFeatureSubgraph:  data.IsFeatureSubgraph.ValueBoolPointer(),
+	}
+
+	resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &filter.Labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := MapSubgraphsFromNative(ctx, FilterSubgraphs(subgraphs, filter))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Subgraphs = result
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,77 @@
+package datasources
+
+import (
+	"testing"
+
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterSubgraphs(t *testing.T) {
+	products := &platformv1.Subgraph{
+		Name:   "products",
+		Labels: []*platformv1.Label{{Key: "team", Value: "a"}, {Key: "env", Value: "production"}},
+	}
+	events := &platformv1.Subgraph{
+		Name:               "events",
+		Labels:             []*platformv1.Label{{Key: "team", Value: "b"}},
+		IsEventDrivenGraph: true,
+	}
+	productsFeature := &platformv1.Subgraph{
+		Name:              "products-feature",
+		Labels:            []*platformv1.Label{{Key: "team", Value: "a"}},
+		IsFeatureSubgraph: true,
+	}
+	subgraphs := []*platformv1.Subgraph{products, events, productsFeature}
+
+	yes := true
+	no := false
+
+	tests := []struct {
+		name     string
+		filter   SubgraphFilter
+		expected []*platformv1.Subgraph
+	}{
+		{
+			name:     "NoFilter",
+			filter:   SubgraphFilter{},
+			expected: subgraphs,
+		},
+		{
+			name:     "Label",
+			filter:   SubgraphFilter{Labels: map[string]string{"team": "a"}},
+			expected: []*platformv1.Subgraph{products, productsFeature},
+		},
+		{
+			name:     "AllLabelsRequired",
+			filter:   SubgraphFilter{Labels: map[string]string{"team": "a", "env": "production"}},
+			expected: []*platformv1.Subgraph{products},
+		},
+		{
+			name:     "LabelValueMismatch",
+			filter:   SubgraphFilter{Labels: map[string]string{"team": "c"}},
+			expected: nil,
+		},
+		{
+			name:     "EventDriven",
+			filter:   SubgraphFilter{IsEventDrivenGraph: &yes},
+			expected: []*platformv1.Subgraph{events},
+		},
+		{
+			name:     "NotFeatureSubgraph",
+			filter:   SubgraphFilter{IsFeatureSubgraph: &no},
+			expected: []*platformv1.Subgraph{products, events},
+		},
+		{
+			name:     "Combined",
+			filter:   SubgraphFilter{Labels: map[string]string{"team": "a"}, IsFeatureSubgraph: &yes},
+			expected: []*platformv1.Subgraph{productsFeature},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FilterSubgraphs(subgraphs, tt.filter))
+		})
+	}
+}
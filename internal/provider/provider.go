@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/internal/datasources"
 	"github.com/labd/terraform-provider-wundergraph/internal/resources"
 	"github.com/labd/terraform-provider-wundergraph/internal/utils"
 	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
@@ -102,11 +103,38 @@ func (p *WundergraphProvider) Resources(ctx context.Context) []func() resource.R
 		resources.NewNamespaceResource,
 		resources.NewFederatedSubgraphResource,
 		resources.NewFederatedGraphResource,
+		resources.NewMonographResource,
+		resources.NewContractResource,
+		resources.NewFeatureFlagResource,
+		resources.NewRouterTokenResource,
+		resources.NewApiKeyResource,
+		resources.NewOIDCProviderResource,
+		resources.NewOrganizationWebhookResource,
+		resources.NewNamespaceLintConfigResource,
+		resources.NewIntegrationResource,
+		resources.NewPersistedOperationsResource,
+		resources.NewOperationOverrideResource,
+		resources.NewOperationIgnoreAllOverrideResource,
+		resources.NewSubgraphMemberResource,
+		resources.NewOrganizationInvitationResource,
+		resources.NewOrganizationMemberRoleResource,
+		resources.NewOrganizationFeatureResource,
+		resources.NewOrganizationResource,
 	}
 }
 
 func (p *WundergraphProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		datasources.NewNamespaceDataSource,
+		datasources.NewNamespacesDataSource,
+		datasources.NewFederatedGraphDataSource,
+		datasources.NewSubgraphDataSource,
+		datasources.NewFederatedGraphsDataSource,
+		datasources.NewSubgraphsDataSource,
+		datasources.NewCompositionsDataSource,
+		datasources.NewRoutersDataSource,
+		datasources.NewSchemaChangelogDataSource,
+	}
 }
 
 func New(version string) func() provider.Provider {
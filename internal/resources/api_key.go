@@ -0,0 +1,459 @@
+package resources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ApiKeyResource{}
+
+func NewApiKeyResource() resource.Resource {
+	return &ApiKeyResource{}
+}
+
+// ApiKeyResource defines the resource implementation.
+type ApiKeyResource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// ApiKeyModel describes the resource data model.
+type ApiKeyModel struct {
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Expires           types.String `tfsdk:"expires"`
+	FederatedGraphIds types.Set    `tfsdk:"federated_graph_ids"`
+	SubgraphIds       types.Set    `tfsdk:"subgraph_ids"`
+	Permissions       types.Set    `tfsdk:"permissions"`
+	AllowAllResources types.Bool   `tfsdk:"allow_all_resources"`
+	Key               types.String `tfsdk:"key"`
+	ExpiresAt         types.String `tfsdk:"expires_at"`
+}
+
+func (r *ApiKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *ApiKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "API key of the organization. An expired key is removed from the state, so it is created again on the next apply. The expired key is deleted right before it is created again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the API key. It must be unique within the organization.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires": schema.StringAttribute{
+				MarkdownDescription: "The period after which the API key expires. The supported values are never, 30_days, 6_months and 1_year. Defaults to `never`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("never"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("never", "30_days", "6_months", "1_year"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"federated_graph_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The ids of the federated graphs the API key has access to.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"subgraph_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The ids of the subgraphs the API key has access to.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The additional permissions of the API key, for example `scim`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"allow_all_resources": schema.BoolAttribute{
+				MarkdownDescription: "Set whether the API key has access to all resources of the organization. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The API key. It is only returned when the key is created.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The time at which the API key expires. Empty if the key never expires.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ApiKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func MapExpiresAt(expires types.String) (platformv1.ExpiresAt, error) {
+	if expires.IsNull() || expires.IsUnknown() {
+		return platformv1.ExpiresAt_NEVER, nil
+	}
+
+	switch expires.ValueString() {
+	case "never":
+		return platformv1.ExpiresAt_NEVER, nil
+	case "30_days":
+		return platformv1.ExpiresAt_THIRTY_DAYS, nil
+	case "6_months":
+		return platformv1.ExpiresAt_SIX_MONTHS, nil
+	case "1_year":
+		return platformv1.ExpiresAt_ONE_YEAR, nil
+	default:
+		return platformv1.ExpiresAt_NEVER, fmt.Errorf("unsupported expiry: %s", expires.ValueString())
+	}
+}
+
+// IsApiKeyExpired reports whether the expiry time returned by the API lies before now. Keys which never expire have
+// an empty expiry time.
+func IsApiKeyExpired(expiresAt string, now time.Time) (bool, error) {
+	if expiresAt == "" {
+		return false, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return false, fmt.Errorf("invalid expiry time %q: %w", expiresAt, err)
+	}
+
+	return !t.After(now), nil
+}
+
+// resolveTargetIds maps the ids of the federated graphs and subgraphs to their target ids, which is how the API
+// refers to them when scoping API keys.
+func (r *ApiKeyResource) resolveTargetIds(ctx context.Context, graphIds []string, subgraphIds []string) ([]string, []string, error) {
+	var graphTargetIds []string
+	if len(graphIds) > 0 {
+		rg, err := r.client.GetFederatedGraphs(ctx, &connect.Request[platformv1.GetFederatedGraphsRequest]{
+			Msg: &platformv1.GetFederatedGraphsRequest{},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if rg.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+			return nil, nil, fmt.Errorf("error fetching federated graph list: %s", rg.Msg.GetResponse().GetDetails())
+		}
+
+		targets := make(map[string]string, len(rg.Msg.Graphs))
+		for _, g := range rg.Msg.Graphs {
+			targets[g.Id] = g.TargetId
+		}
+
+		for _, id := range graphIds {
+			t, ok := targets[id]
+			if !ok {
+				return nil, nil, fmt.Errorf("federated graph %s not found", id)
+			}
+			graphTargetIds = append(graphTargetIds, t)
+		}
+	}
+
+	var subgraphTargetIds []string
+	if len(subgraphIds) > 0 {
+		rs, err := r.client.GetSubgraphs(ctx, &connect.Request[platformv1.GetSubgraphsRequest]{
+			Msg: &platformv1.GetSubgraphsRequest{},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if rs.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+			return nil, nil, fmt.Errorf("error fetching subgraph list: %s", rs.Msg.GetResponse().GetDetails())
+		}
+
+		targets := make(map[string]string, len(rs.Msg.Graphs))
+		for _, s := range rs.Msg.Graphs {
+			targets[s.Id] = s.TargetId
+		}
+
+		for _, id := range subgraphIds {
+			t, ok := targets[id]
+			if !ok {
+				return nil, nil, fmt.Errorf("subgraph %s not found", id)
+			}
+			subgraphTargetIds = append(subgraphTargetIds, t)
+		}
+	}
+
+	return graphTargetIds, subgraphTargetIds, nil
+}
+
+func (r *ApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ApiKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expires, err := MapExpiresAt(plan.Expires)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating API key", err.Error())
+		return
+	}
+
+	var graphIds, subgraphIds, permissions []string
+	resp.Diagnostics.Append(plan.FederatedGraphIds.ElementsAs(ctx, &graphIds, false)...)
+	resp.Diagnostics.Append(plan.SubgraphIds.ElementsAs(ctx, &subgraphIds, false)...)
+	resp.Diagnostics.Append(plan.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	graphTargetIds, subgraphTargetIds, err := r.resolveTargetIds(ctx, graphIds, subgraphIds)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating API key", err.Error())
+		return
+	}
+
+	if err := r.deleteExpiredKey(ctx, plan.Name.ValueString(), time.Now()); err != nil {
+		resp.Diagnostics.AddError("Error creating API key", err.Error())
+		return
+	}
+
+	rc, err := r.client.CreateAPIKey(ctx, &connect.Request[platformv1.CreateAPIKeyRequest]{
+		Msg: &platformv1.CreateAPIKeyRequest{
+			Name:                    plan.Name.ValueString(),
+			Expires:                 expires,
+			FederatedGraphTargetIds: graphTargetIds,
+			SubgraphTargetIds:       subgraphTargetIds,
+			Permissions:             permissions,
+			AllowAllResources:       plan.AllowAllResources.ValueBool(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating API key", err.Error())
+		return
+	}
+
+	if rc.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error creating API key", rc.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	// The key is only returned once, so we save it before looking up the id in case the lookup fails.
+	plan.Key = types.StringValue(rc.Msg.ApiKey)
+	plan.Id = types.StringNull()
+	plan.ExpiresAt = types.StringNull()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// We fetch the API key list to get the id and expiry of the key, as they are not part of the create response.
+	key, err := r.findKey(ctx, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading API key", err.Error())
+		return
+	}
+
+	if key == nil {
+		resp.Diagnostics.AddError("Error reading API key", "API key not found")
+		return
+	}
+
+	plan.Id = types.StringValue(key.Id)
+	plan.ExpiresAt = types.StringValue(key.ExpiresAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ApiKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := r.findKey(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading API key", err.Error())
+		return
+	}
+
+	// The key has been deleted outside of terraform, so it has to be created again.
+	if key == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	expired, err := IsApiKeyExpired(key.ExpiresAt, time.Now())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading API key", err.Error())
+		return
+	}
+
+	// The expired key is only deleted when it is created again, so a plan or refresh does not change anything.
+	if expired {
+		resp.Diagnostics.AddWarning("API key expired", fmt.Sprintf("The API key %s expired at %s and will be created again.", key.Name, key.ExpiresAt))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.StringValue(key.Id)
+	data.ExpiresAt = types.StringValue(key.ExpiresAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require a replacement, so there is nothing to update in place.
+	var plan ApiKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ApiKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rd, err := r.client.DeleteAPIKey(ctx, &connect.Request[platformv1.DeleteAPIKeyRequest]{
+		Msg: &platformv1.DeleteAPIKeyRequest{
+			Name: data.Name.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting API key", err.Error())
+		return
+	}
+
+	if rd.Msg.GetResponse().Code != common.EnumStatusCode_OK && rd.Msg.GetResponse().Code != common.EnumStatusCode_ERR_NOT_FOUND {
+		resp.Diagnostics.AddError("Error deleting API key", rd.Msg.GetResponse().GetDetails())
+		return
+	}
+}
+
+// deleteExpiredKey deletes the API key with the given name if it has expired. The name of a key has to be unique, so
+// an expired key would otherwise prevent the key from being created again.
+func (r *ApiKeyResource) deleteExpiredKey(ctx context.Context, name string, now time.Time) error {
+	key, err := r.findKey(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if key == nil {
+		return nil
+	}
+
+	expired, err := IsApiKeyExpired(key.ExpiresAt, now)
+	if err != nil {
+		return err
+	}
+
+	if !expired {
+		return nil
+	}
+
+	rd, err := r.client.DeleteAPIKey(ctx, &connect.Request[platformv1.DeleteAPIKeyRequest]{
+		Msg: &platformv1.DeleteAPIKeyRequest{
+			Name: key.Name,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if rd.Msg.GetResponse().Code != common.EnumStatusCode_OK && rd.Msg.GetResponse().Code != common.EnumStatusCode_ERR_NOT_FOUND {
+		return fmt.Errorf("error deleting expired API key: %s", rd.Msg.GetResponse().GetDetails())
+	}
+
+	return nil
+}
+
+// findKey looks up the API key by its name, which is unique within the organization. It returns nil if the key does
+// not exist.
+func (r *ApiKeyResource) findKey(ctx context.Context, name string) (*platformv1.APIKey, error) {
+	rk, err := r.client.GetAPIKeys(ctx, &connect.Request[platformv1.GetAPIKeysRequest]{
+		Msg: &platformv1.GetAPIKeysRequest{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rk.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		return nil, fmt.Errorf("error fetching API key list: %s", rk.Msg.GetResponse().GetDetails())
+	}
+
+	for _, k := range rk.Msg.ApiKeys {
+		if k.Name == name {
+			return k, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,221 @@
+package resources
+
+import (
+	"context"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapExpiresAt(t *testing.T) {
+	tests := []struct {
+		name        string
+		expires     types.String
+		expected    platformv1.ExpiresAt
+		expectError bool
+	}{
+		{
+			name:     "ValidInputNever",
+			expires:  types.StringValue("never"),
+			expected: platformv1.ExpiresAt_NEVER,
+		},
+		{
+			name:     "ValidInputThirtyDays",
+			expires:  types.StringValue("30_days"),
+			expected: platformv1.ExpiresAt_THIRTY_DAYS,
+		},
+		{
+			name:     "ValidInputSixMonths",
+			expires:  types.StringValue("6_months"),
+			expected: platformv1.ExpiresAt_SIX_MONTHS,
+		},
+		{
+			name:     "ValidInputOneYear",
+			expires:  types.StringValue("1_year"),
+			expected: platformv1.ExpiresAt_ONE_YEAR,
+		},
+		{
+			name:        "InvalidInput",
+			expires:     types.StringValue("invalid"),
+			expectError: true,
+		},
+		{
+			name:     "NullInput",
+			expires:  types.StringNull(),
+			expected: platformv1.ExpiresAt_NEVER,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := MapExpiresAt(tt.expires)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestIsApiKeyExpired(t *testing.T) {
+	now := time.Date(2024, 7, 29, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		expiresAt   string
+		expected    bool
+		expectError bool
+	}{
+		{
+			name:      "NeverExpires",
+			expiresAt: "",
+			expected:  false,
+		},
+		{
+			name:      "Expired",
+			expiresAt: "2024-07-28T12:00:00.000Z",
+			expected:  true,
+		},
+		{
+			name:      "NotExpired",
+			expiresAt: "2024-08-28T12:00:00.000Z",
+			expected:  false,
+		},
+		{
+			name:        "InvalidInput",
+			expiresAt:   "tomorrow",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := IsApiKeyExpired(tt.expiresAt, now)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestDeleteExpiredKey(t *testing.T) {
+	now := time.Date(2024, 7, 29, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		keys            []*platformv1.APIKey
+		expectedDeleted []string
+	}{
+		{
+			name: "Active",
+			keys: []*platformv1.APIKey{{Id: "1", Name: "ci", ExpiresAt: "2024-08-28T12:00:00.000Z"}},
+		},
+		{
+			name: "NeverExpires",
+			keys: []*platformv1.APIKey{{Id: "1", Name: "ci"}},
+		},
+		{
+			name:            "Expired",
+			keys:            []*platformv1.APIKey{{Id: "1", Name: "ci", ExpiresAt: "2024-07-28T12:00:00.000Z"}},
+			expectedDeleted: []string{"ci"},
+		},
+		{
+			name: "NotFound",
+			keys: []*platformv1.APIKey{{Id: "2", Name: "other", ExpiresAt: "2024-07-28T12:00:00.000Z"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deleted []string
+			r := &ApiKeyResource{client: &fakeClient{
+				getAPIKeys: func(*platformv1.GetAPIKeysRequest) *platformv1.GetAPIKeysResponse {
+					return &platformv1.GetAPIKeysResponse{
+						Response: &platformv1.Response{Code: common.EnumStatusCode_OK},
+						ApiKeys:  tt.keys,
+					}
+				},
+				deleteAPIKey: func(req *platformv1.DeleteAPIKeyRequest) *platformv1.DeleteAPIKeyResponse {
+					deleted = append(deleted, req.Name)
+					return &platformv1.DeleteAPIKeyResponse{
+						Response: &platformv1.Response{Code: common.EnumStatusCode_OK},
+					}
+				},
+			}}
+
+			err := r.deleteExpiredKey(context.Background(), "ci", now)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedDeleted, deleted)
+		})
+	}
+}
+
+func TestApiKeyRead(t *testing.T) {
+	tests := []struct {
+		name            string
+		keys            []*platformv1.APIKey
+		expectedRemoved bool
+		expectWarning   bool
+	}{
+		{
+			name: "Active",
+			keys: []*platformv1.APIKey{{Id: "1", Name: "ci"}},
+		},
+		{
+			name:            "Expired",
+			keys:            []*platformv1.APIKey{{Id: "1", Name: "ci", ExpiresAt: "2020-01-01T00:00:00.000Z"}},
+			expectedRemoved: true,
+			expectWarning:   true,
+		},
+		{
+			name:            "NotFound",
+			expectedRemoved: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// DeleteAPIKey is not set, so the test panics if the read deletes the key.
+			r := &ApiKeyResource{client: &fakeClient{
+				getAPIKeys: func(*platformv1.GetAPIKeysRequest) *platformv1.GetAPIKeysResponse {
+					return &platformv1.GetAPIKeysResponse{
+						Response: &platformv1.Response{Code: common.EnumStatusCode_OK},
+						ApiKeys:  tt.keys,
+					}
+				},
+			}}
+
+			state := newTestState(t, &ApiKeyResource{}, &ApiKeyModel{
+				Id:                types.StringValue("1"),
+				Name:              types.StringValue("ci"),
+				Expires:           types.StringValue("never"),
+				FederatedGraphIds: types.SetNull(types.StringType),
+				SubgraphIds:       types.SetNull(types.StringType),
+				Permissions:       types.SetNull(types.StringType),
+				AllowAllResources: types.BoolValue(false),
+				Key:               types.StringValue("secret"),
+				ExpiresAt:         types.StringValue(""),
+			})
+			resp := resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+
+			require.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.expectedRemoved, resp.State.Raw.IsNull())
+			assert.Equal(t, tt.expectWarning, resp.Diagnostics.WarningsCount() > 0)
+		})
+	}
+}
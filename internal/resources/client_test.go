@@ -0,0 +1,75 @@
+package resources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// fakeClient implements the platform service client for tests. Only the methods a test needs have to be set, calling
+// any other method panics on the embedded nil client.
+type fakeClient struct {
+	platformv1connect.PlatformServiceClient
+
+	getAPIKeys   func(*platformv1.GetAPIKeysRequest) *platformv1.GetAPIKeysResponse
+	deleteAPIKey func(*platformv1.DeleteAPIKeyRequest) *platformv1.DeleteAPIKeyResponse
+
+	whoAmI             func(*platformv1.WhoAmIRequest) *platformv1.WhoAmIResponse
+	deleteOrganization func(*platformv1.DeleteOrganizationRequest) *platformv1.DeleteOrganizationResponse
+
+	getOrganizationMembers        func(*platformv1.GetOrganizationMembersRequest) *platformv1.GetOrganizationMembersResponse
+	getPendingOrganizationMembers func(*platformv1.GetPendingOrganizationMembersRequest) *platformv1.GetPendingOrganizationMembersResponse
+}
+
+func (c *fakeClient) GetAPIKeys(ctx context.Context, req *connect.Request[platformv1.GetAPIKeysRequest]) (*connect.Response[platformv1.GetAPIKeysResponse], error) {
+	return connect.NewResponse(c.getAPIKeys(req.Msg)), nil
+}
+
+func (c *fakeClient) DeleteAPIKey(ctx context.Context, req *connect.Request[platformv1.DeleteAPIKeyRequest]) (*connect.Response[platformv1.DeleteAPIKeyResponse], error) {
+	return connect.NewResponse(c.deleteAPIKey(req.Msg)), nil
+}
+
+func (c *fakeClient) WhoAmI(ctx context.Context, req *connect.Request[platformv1.WhoAmIRequest]) (*connect.Response[platformv1.WhoAmIResponse], error) {
+	return connect.NewResponse(c.whoAmI(req.Msg)), nil
+}
+
+func (c *fakeClient) DeleteOrganization(ctx context.Context, req *connect.Request[platformv1.DeleteOrganizationRequest]) (*connect.Response[platformv1.DeleteOrganizationResponse], error) {
+	return connect.NewResponse(c.deleteOrganization(req.Msg)), nil
+}
+
+func (c *fakeClient) GetOrganizationMembers(ctx context.Context, req *connect.Request[platformv1.GetOrganizationMembersRequest]) (*connect.Response[platformv1.GetOrganizationMembersResponse], error) {
+	return connect.NewResponse(c.getOrganizationMembers(req.Msg)), nil
+}
+
+func (c *fakeClient) GetPendingOrganizationMembers(ctx context.Context, req *connect.Request[platformv1.GetPendingOrganizationMembersRequest]) (*connect.Response[platformv1.GetPendingOrganizationMembersResponse], error) {
+	return connect.NewResponse(c.getPendingOrganizationMembers(req.Msg)), nil
+}
+
+// newTestState returns the state of the resource holding the given model.
+func newTestState(t *testing.T, r resource.Resource, data any) tfsdk.State {
+	ctx := context.Background()
+
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	state := tfsdk.State{
+		Schema: resp.Schema,
+		Raw:    tftypes.NewValue(resp.Schema.Type().TerraformType(ctx), nil),
+	}
+	require.False(t, state.Set(ctx, data).HasError())
+
+	return state
+}
+
+// pageOf returns the page of items selected by the pagination of a request.
+func pageOf[T any](items []T, pagination *platformv1.Pagination) []T {
+	start := min(int(pagination.Offset), len(items))
+	end := min(start+int(pagination.Limit), len(items))
+	return items[start:end]
+}
@@ -0,0 +1,26 @@
+package resources
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+)
+
+// AddCompositionDiagnostics adds the composition and deployment errors returned by the API as warnings, as they
+// describe why the change could not be rolled out rather than why it failed.
+func AddCompositionDiagnostics(diags *diag.Diagnostics, action string, compositionErrors []*platformv1.CompositionError, deploymentErrors []*platformv1.DeploymentError) {
+	for _, e := range compositionErrors {
+		diags.AddWarning(fmt.Sprintf("Composition errors when %s", action), e.Message)
+	}
+
+	for _, e := range deploymentErrors {
+		diags.AddWarning(fmt.Sprintf("Deployment errors when %s", action), e.Message)
+	}
+}
+
+// IsCompositionFailure reports whether the status code means that the change has been stored, but the graph could
+// not be composed or deployed with it.
+func IsCompositionFailure(code common.EnumStatusCode) bool {
+	return code == common.EnumStatusCode_ERR_SUBGRAPH_COMPOSITION_FAILED || code == common.EnumStatusCode_ERR_DEPLOYMENT_FAILED
+}
@@ -0,0 +1,41 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddCompositionDiagnostics(t *testing.T) {
+	var diags diag.Diagnostics
+	AddCompositionDiagnostics(&diags, "creating contract",
+		[]*platformv1.CompositionError{{Message: "field conflict"}},
+		[]*platformv1.DeploymentError{{Message: "admission denied"}},
+	)
+
+	assert.Equal(t, diag.Diagnostics{
+		diag.NewWarningDiagnostic("Composition errors when creating contract", "field conflict"),
+		diag.NewWarningDiagnostic("Deployment errors when creating contract", "admission denied"),
+	}, diags)
+}
+
+func TestIsCompositionFailure(t *testing.T) {
+	tests := []struct {
+		code     common.EnumStatusCode
+		expected bool
+	}{
+		{code: common.EnumStatusCode_OK, expected: false},
+		{code: common.EnumStatusCode_ERR_SUBGRAPH_COMPOSITION_FAILED, expected: true},
+		{code: common.EnumStatusCode_ERR_DEPLOYMENT_FAILED, expected: true},
+		{code: common.EnumStatusCode_ERR_ALREADY_EXISTS, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsCompositionFailure(tt.code))
+		})
+	}
+}
@@ -0,0 +1,372 @@
+package resources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ContractResource{}
+var _ resource.ResourceWithImportState = &ContractResource{}
+
+func NewContractResource() resource.Resource {
+	return &ContractResource{}
+}
+
+// ContractResource defines the resource implementation.
+type ContractResource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// ContractModel describes the resource data model.
+type ContractModel struct {
+	Id                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Namespace              types.String `tfsdk:"namespace"`
+	SourceGraphName        types.String `tfsdk:"source_graph_name"`
+	RoutingUrl             types.String `tfsdk:"routing_url"`
+	ExcludeTags            types.Set    `tfsdk:"exclude_tags"`
+	Readme                 types.String `tfsdk:"readme"`
+	AdmissionWebhookUrl    types.String `tfsdk:"admission_webhook_url"`
+	AdmissionWebhookSecret types.String `tfsdk:"admission_webhook_secret"`
+}
+
+func (r *ContractResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contract"
+}
+
+func (r *ContractResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Schema contract, a federated graph which exposes a filtered version of its source graph.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the contract to create.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The namespace name of the contract and its source graph. Defaults to `default`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_graph_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the federated graph the contract is created from.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"routing_url": schema.StringAttribute{
+				MarkdownDescription: "The routing url of the router serving the contract. This is the url that the router will be accessible at.",
+				Required:            true,
+			},
+			"exclude_tags": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The schema elements tagged with any of these tags are removed from the contract schema.",
+				Required:            true,
+			},
+			"readme": schema.StringAttribute{
+				MarkdownDescription: "The markdown text which describes the contract.",
+				Optional:            true,
+			},
+			"admission_webhook_url": schema.StringAttribute{
+				MarkdownDescription: "The admission webhook url. This is the url that the controlplane will use to implement admission control for the contract.",
+				Optional:            true,
+			},
+			"admission_webhook_secret": schema.StringAttribute{
+				MarkdownDescription: "The admission webhook secret is used to sign requests to the webhook url.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *ContractResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ContractResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ContractModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var excludeTags []string
+	resp.Diagnostics.Append(plan.ExcludeTags.ElementsAs(ctx, &excludeTags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rc, err := r.client.CreateContract(ctx, &connect.Request[platformv1.CreateContractRequest]{
+		Msg: &platformv1.CreateContractRequest{
+			Name:                   plan.Name.ValueString(),
+			Namespace:              plan.Namespace.ValueString(),
+			SourceGraphName:        plan.SourceGraphName.ValueString(),
+			RoutingUrl:             plan.RoutingUrl.ValueString(),
+			ExcludeTags:            excludeTags,
+			Readme:                 plan.Readme.ValueStringPointer(),
+			AdmissionWebhookUrl:    plan.AdmissionWebhookUrl.ValueString(),
+			AdmissionWebhookSecret: plan.AdmissionWebhookSecret.ValueStringPointer(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating contract", err.Error())
+		return
+	}
+
+	// The contract has been created when only its composition or deployment failed, so it still has to be saved.
+	AddCompositionDiagnostics(&resp.Diagnostics, "creating contract", rc.Msg.CompositionErrors, rc.Msg.DeploymentErrors)
+
+	if code := rc.Msg.GetResponse().Code; code != common.EnumStatusCode_OK && !IsCompositionFailure(code) {
+		resp.Diagnostics.AddError("Error creating contract", rc.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	// We fetch the FederatedGraph list to get the requested contract, as we don't have a direct read endpoint.
+	ns, err := r.client.GetFederatedGraphs(ctx, &connect.Request[platformv1.GetFederatedGraphsRequest]{
+		Msg: &platformv1.GetFederatedGraphsRequest{
+			Namespace: plan.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading contract", err.Error())
+		return
+	}
+
+	var isFound bool
+	for _, n := range ns.Msg.Graphs {
+		if n.Name == plan.Name.ValueString() {
+			plan.Id = types.StringValue(n.Id)
+			isFound = true
+			continue
+		}
+	}
+
+	if !isFound {
+		resp.Diagnostics.AddError("Error reading contract", "contract not found")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ContractResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ContractModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// We fetch the FederatedGraph list to get the requested contract, as we don't have a direct read endpoint. The
+	// source graph lives in the same namespace, so the same list is used to resolve its name.
+	ns, err := r.client.GetFederatedGraphs(ctx, &connect.Request[platformv1.GetFederatedGraphsRequest]{
+		Msg: &platformv1.GetFederatedGraphsRequest{
+			Namespace: data.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading contract", err.Error())
+		return
+	}
+
+	if ns.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error fetching contract list", ns.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	var graph *platformv1.FederatedGraph
+	graphNames := make(map[string]string, len(ns.Msg.Graphs))
+	for _, n := range ns.Msg.Graphs {
+		graphNames[n.Id] = n.Name
+		if n.Id == data.Id.ValueString() {
+			graph = n
+		}
+	}
+
+	if graph == nil {
+		resp.Diagnostics.AddError("Error reading contract", "contract not found")
+		return
+	}
+
+	if graph.Contract == nil {
+		resp.Diagnostics.AddError("Error reading contract", fmt.Sprintf("federated graph %s is not a contract", graph.Name))
+		return
+	}
+
+	sourceGraphName, ok := graphNames[graph.Contract.SourceFederatedGraphId]
+	if !ok {
+		resp.Diagnostics.AddError("Error reading contract", "source graph of the contract not found")
+		return
+	}
+
+	excludeTags, diags := types.SetValueFrom(ctx, types.StringType, graph.Contract.ExcludeTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var admissionWebhookUrl *string
+	if graph.AdmissionWebhookUrl != nil && *(graph.AdmissionWebhookUrl) != "" {
+		admissionWebhookUrl = graph.AdmissionWebhookUrl
+	}
+
+	var readme *string
+	if graph.Readme != nil && *(graph.Readme) != "" {
+		readme = graph.Readme
+	}
+
+	current := &ContractModel{
+		Id:                  types.StringValue(graph.Id),
+		Name:                types.StringValue(graph.Name),
+		Namespace:           types.StringValue(graph.Namespace),
+		SourceGraphName:     types.StringValue(sourceGraphName),
+		RoutingUrl:          types.StringValue(graph.RoutingURL),
+		ExcludeTags:         excludeTags,
+		Readme:              types.StringPointerValue(readme),
+		AdmissionWebhookUrl: types.StringPointerValue(admissionWebhookUrl),
+		// The secret is never returned by the API, so we keep the value from the state.
+		AdmissionWebhookSecret: data.AdmissionWebhookSecret,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &current)...)
+}
+
+func (r *ContractResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ContractModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ContractModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The exclude tags are the only setting specific to contracts, the remaining settings are shared with federated graphs.
+	if !plan.ExcludeTags.Equal(state.ExcludeTags) {
+		var excludeTags []string
+		resp.Diagnostics.Append(plan.ExcludeTags.ElementsAs(ctx, &excludeTags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		ru, err := r.client.UpdateContract(ctx, &connect.Request[platformv1.UpdateContractRequest]{
+			Msg: &platformv1.UpdateContractRequest{
+				Name:        plan.Name.ValueString(),
+				Namespace:   plan.Namespace.ValueString(),
+				ExcludeTags: excludeTags,
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating contract", err.Error())
+			return
+		}
+
+		AddCompositionDiagnostics(&resp.Diagnostics, "updating contract", ru.Msg.CompositionErrors, ru.Msg.DeploymentErrors)
+
+		if code := ru.Msg.GetResponse().Code; code != common.EnumStatusCode_OK && !IsCompositionFailure(code) {
+			resp.Diagnostics.AddError("Error updating contract", ru.Msg.GetResponse().GetDetails())
+			return
+		}
+	}
+
+	if !plan.RoutingUrl.Equal(state.RoutingUrl) ||
+		!plan.Readme.Equal(state.Readme) ||
+		!plan.AdmissionWebhookUrl.Equal(state.AdmissionWebhookUrl) ||
+		!plan.AdmissionWebhookSecret.Equal(state.AdmissionWebhookSecret) {
+		ru, err := r.client.UpdateFederatedGraph(ctx, &connect.Request[platformv1.UpdateFederatedGraphRequest]{
+			Msg: &platformv1.UpdateFederatedGraphRequest{
+				Name:                   plan.Name.ValueString(),
+				Namespace:              plan.Namespace.ValueString(),
+				RoutingUrl:             plan.RoutingUrl.ValueString(),
+				Readme:                 MapReadmeUpdate(plan.Readme, state.Readme),
+				AdmissionWebhookURL:    plan.AdmissionWebhookUrl.ValueStringPointer(),
+				AdmissionWebhookSecret: plan.AdmissionWebhookSecret.ValueStringPointer(),
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating contract", err.Error())
+			return
+		}
+
+		if ru.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+			resp.Diagnostics.AddError("Error updating contract", ru.Msg.GetResponse().GetDetails())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ContractResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ContractModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A contract is a federated graph, so it is removed through the federated graph endpoint.
+	rd, err := r.client.DeleteFederatedGraph(ctx, &connect.Request[platformv1.DeleteFederatedGraphRequest]{
+		Msg: &platformv1.DeleteFederatedGraphRequest{
+			Name:      data.Name.ValueString(),
+			Namespace: data.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting contract", err.Error())
+		return
+	}
+
+	if rd.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error deleting contract", rd.Msg.GetResponse().GetDetails())
+		return
+	}
+}
+
+func (r *ContractResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,363 @@
+package resources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FeatureFlagResource{}
+var _ resource.ResourceWithImportState = &FeatureFlagResource{}
+
+func NewFeatureFlagResource() resource.Resource {
+	return &FeatureFlagResource{}
+}
+
+// FeatureFlagResource defines the resource implementation.
+type FeatureFlagResource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// FeatureFlagModel describes the resource data model.
+type FeatureFlagModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Namespace            types.String `tfsdk:"namespace"`
+	Labels               types.Map    `tfsdk:"labels"`
+	FeatureSubgraphNames types.Set    `tfsdk:"feature_subgraph_names"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *FeatureFlagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_flag"
+}
+
+func (r *FeatureFlagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Feature flag, which replaces subgraphs with feature subgraphs in the federated graphs matching its labels.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the feature flag to create.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The namespace name of the feature flag. Defaults to `default`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The labels of the feature flag. The feature flag is applied to the federated graphs whose label matchers match these labels.",
+				Optional:            true,
+			},
+			"feature_subgraph_names": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the feature subgraphs which are part of the feature flag.",
+				Required:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Set whether the feature flag is enabled. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *FeatureFlagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FeatureFlagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *FeatureFlagModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var labels map[string]string
+	resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var featureSubgraphNames []string
+	resp.Diagnostics.Append(plan.FeatureSubgraphNames.ElementsAs(ctx, &featureSubgraphNames, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rc, err := r.client.CreateFeatureFlag(ctx, &connect.Request[platformv1.CreateFeatureFlagRequest]{
+		Msg: &platformv1.CreateFeatureFlagRequest{
+			Name:                 plan.Name.ValueString(),
+			Namespace:            plan.Namespace.ValueString(),
+			Labels:               MapLabelsToNative(labels),
+			FeatureSubgraphNames: featureSubgraphNames,
+			IsEnabled:            plan.Enabled.ValueBool(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating feature flag", err.Error())
+		return
+	}
+
+	// The feature flag has been created when only its composition or deployment failed, so it still has to be saved.
+	AddCompositionDiagnostics(&resp.Diagnostics, "creating feature flag", rc.Msg.CompositionErrors, rc.Msg.DeploymentErrors)
+
+	if code := rc.Msg.GetResponse().Code; code != common.EnumStatusCode_OK && !IsCompositionFailure(code) {
+		resp.Diagnostics.AddError("Error creating feature flag", rc.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	rg, err := r.client.GetFeatureFlagByName(ctx, &connect.Request[platformv1.GetFeatureFlagByNameRequest]{
+		Msg: &platformv1.GetFeatureFlagByNameRequest{
+			Name:      plan.Name.ValueString(),
+			Namespace: plan.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading feature flag", err.Error())
+		return
+	}
+
+	if rg.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error reading feature flag", rg.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	plan.Id = types.StringValue(rg.Msg.FeatureFlag.Id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FeatureFlagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FeatureFlagModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// We fetch the feature flag list to find the feature flag by its id, the name is only known after that.
+	ns, err := r.client.GetFeatureFlags(ctx, &connect.Request[platformv1.GetFeatureFlagsRequest]{
+		Msg: &platformv1.GetFeatureFlagsRequest{
+			Namespace: data.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading feature flag", err.Error())
+		return
+	}
+
+	if ns.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error fetching feature flag list", ns.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	var featureFlag *platformv1.FeatureFlag
+	for _, n := range ns.Msg.FeatureFlags {
+		if n.Id == data.Id.ValueString() {
+			featureFlag = n
+			continue
+		}
+	}
+
+	if featureFlag == nil {
+		resp.Diagnostics.AddError("Error reading feature flag", "feature flag not found")
+		return
+	}
+
+	rg, err := r.client.GetFeatureFlagByName(ctx, &connect.Request[platformv1.GetFeatureFlagByNameRequest]{
+		Msg: &platformv1.GetFeatureFlagByNameRequest{
+			Name:      featureFlag.Name,
+			Namespace: featureFlag.Namespace,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading feature flag", err.Error())
+		return
+	}
+
+	if rg.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error reading feature flag", rg.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	var featureSubgraphNames []string
+	for _, s := range rg.Msg.FeatureSubgraphs {
+		featureSubgraphNames = append(featureSubgraphNames, s.Name)
+	}
+
+	subgraphs, diags := types.SetValueFrom(ctx, types.StringType, featureSubgraphNames)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Labels are optional, so we keep them null when none are set to avoid a diff with an omitted attribute.
+	labels := types.MapNull(types.StringType)
+	if len(rg.Msg.FeatureFlag.Labels) > 0 || !data.Labels.IsNull() {
+		labels, diags = types.MapValueFrom(ctx, types.StringType, MapLabelsFromNative(rg.Msg.FeatureFlag.Labels))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	current := &FeatureFlagModel{
+		Id:                   types.StringValue(rg.Msg.FeatureFlag.Id),
+		Name:                 types.StringValue(rg.Msg.FeatureFlag.Name),
+		Namespace:            types.StringValue(rg.Msg.FeatureFlag.Namespace),
+		Labels:               labels,
+		FeatureSubgraphNames: subgraphs,
+		Enabled:              types.BoolValue(rg.Msg.FeatureFlag.IsEnabled),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &current)...)
+}
+
+func (r *FeatureFlagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan FeatureFlagModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state FeatureFlagModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Labels.Equal(state.Labels) || !plan.FeatureSubgraphNames.Equal(state.FeatureSubgraphNames) {
+		var labels map[string]string
+		resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var featureSubgraphNames []string
+		resp.Diagnostics.Append(plan.FeatureSubgraphNames.ElementsAs(ctx, &featureSubgraphNames, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		ru, err := r.client.UpdateFeatureFlag(ctx, &connect.Request[platformv1.UpdateFeatureFlagRequest]{
+			Msg: &platformv1.UpdateFeatureFlagRequest{
+				Name:                 plan.Name.ValueString(),
+				Namespace:            plan.Namespace.ValueString(),
+				Labels:               MapLabelsToNative(labels),
+				FeatureSubgraphNames: featureSubgraphNames,
+				// An empty label list leaves the labels untouched, so removing all labels has to be explicit.
+				UnsetLabels: len(labels) == 0,
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating feature flag", err.Error())
+			return
+		}
+
+		AddCompositionDiagnostics(&resp.Diagnostics, "updating feature flag", ru.Msg.CompositionErrors, ru.Msg.DeploymentErrors)
+
+		if code := ru.Msg.GetResponse().Code; code != common.EnumStatusCode_OK && !IsCompositionFailure(code) {
+			resp.Diagnostics.AddError("Error updating feature flag", ru.Msg.GetResponse().GetDetails())
+			return
+		}
+	}
+
+	// The enabled state is toggled in place, so the feature flag does not need to be recreated.
+	if !plan.Enabled.Equal(state.Enabled) {
+		re, err := r.client.EnableFeatureFlag(ctx, &connect.Request[platformv1.EnableFeatureFlagRequest]{
+			Msg: &platformv1.EnableFeatureFlagRequest{
+				Name:      plan.Name.ValueString(),
+				Namespace: plan.Namespace.ValueString(),
+				Enabled:   plan.Enabled.ValueBool(),
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating feature flag", err.Error())
+			return
+		}
+
+		AddCompositionDiagnostics(&resp.Diagnostics, "updating feature flag", re.Msg.CompositionErrors, re.Msg.DeploymentErrors)
+
+		if code := re.Msg.GetResponse().Code; code != common.EnumStatusCode_OK && !IsCompositionFailure(code) {
+			resp.Diagnostics.AddError("Error updating feature flag", re.Msg.GetResponse().GetDetails())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FeatureFlagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *FeatureFlagModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rd, err := r.client.DeleteFeatureFlag(ctx, &connect.Request[platformv1.DeleteFeatureFlagRequest]{
+		Msg: &platformv1.DeleteFeatureFlagRequest{
+			Name:      data.Name.ValueString(),
+			Namespace: data.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting feature flag", err.Error())
+		return
+	}
+
+	AddCompositionDiagnostics(&resp.Diagnostics, "deleting feature flag", rd.Msg.CompositionErrors, rd.Msg.DeploymentErrors)
+
+	if code := rd.Msg.GetResponse().Code; code != common.EnumStatusCode_OK && !IsCompositionFailure(code) {
+		resp.Diagnostics.AddError("Error deleting feature flag", rd.Msg.GetResponse().GetDetails())
+		return
+	}
+}
+
+func (r *FeatureFlagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -40,6 +40,7 @@ type FederatedGraphModel struct {
 	LabelMatchers          LabelMatchers `tfsdk:"label_matchers"`
 	AdmissionWebhookUrl    types.String  `tfsdk:"admission_webhook_url"`
 	AdmissionWebhookSecret types.String  `tfsdk:"admission_webhook_secret"`
+	Readme                 types.String  `tfsdk:"readme"`
 }
 
 type LabelMatcher struct {
@@ -114,6 +115,10 @@ func (r *FederatedGraphResource) Schema(ctx context.Context, req resource.Schema
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"readme": schema.StringAttribute{
+				MarkdownDescription: "The markdown text which describes the federated graph. Use the `file` function to read it from a file.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -178,6 +183,7 @@ func (r *FederatedGraphResource) Create(ctx context.Context, req resource.Create
 			AdmissionWebhookURL:    plan.AdmissionWebhookUrl.ValueString(),
 			AdmissionWebhookSecret: plan.AdmissionWebhookSecret.ValueStringPointer(),
 			LabelMatchers:          labels,
+			Readme:                 plan.Readme.ValueStringPointer(),
 		},
 	})
 	if err != nil {
@@ -292,6 +298,11 @@ func (r *FederatedGraphResource) Read(ctx context.Context, req resource.ReadRequ
 				admissionWebhookUrl = n.AdmissionWebhookUrl
 			}
 
+			var readme *string
+			if n.Readme != nil && *(n.Readme) != "" {
+				readme = n.Readme
+			}
+
 			labelMatchers, d := MapLabelMatchersFromNative(ctx, n.LabelMatchers)
 			if d.HasError() {
 				resp.Diagnostics.Append(d...)
@@ -305,6 +316,7 @@ func (r *FederatedGraphResource) Read(ctx context.Context, req resource.ReadRequ
 				RoutingUrl:          types.StringValue(n.RoutingURL),
 				AdmissionWebhookUrl: types.StringPointerValue(admissionWebhookUrl),
 				LabelMatchers:       labelMatchers,
+				Readme:              types.StringPointerValue(readme),
 			}
 			continue
 		}
@@ -357,6 +369,13 @@ func (r *FederatedGraphResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	if !plan.Readme.Equal(state.Readme) {
+		updateReadme(ctx, r.client, plan.Name.ValueString(), plan.Namespace.ValueString(), plan.Readme, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -380,6 +399,37 @@ func (r *FederatedGraphResource) Delete(ctx context.Context, req resource.Delete
 	}
 }
 
+// updateReadme replaces the readme of the federated graph or subgraph with the given name. A null readme clears it.
+func updateReadme(ctx context.Context, client platformv1connect.PlatformServiceClient, name, namespace string, readme types.String, diags *diag.Diagnostics) {
+	ra, err := client.AddReadme(ctx, &connect.Request[platformv1.AddReadmeRequest]{
+		Msg: &platformv1.AddReadmeRequest{
+			TargetName: name,
+			Readme:     readme.ValueString(),
+			Namespace:  namespace,
+		},
+	})
+	if err != nil {
+		diags.AddError("Error updating readme", err.Error())
+		return
+	}
+
+	if ra.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		diags.AddError("Error updating readme", ra.Msg.GetResponse().GetDetails())
+		return
+	}
+}
+
+// MapReadmeUpdate returns the readme to send with an update request. The API leaves the readme unchanged when it is
+// nil, so a readme that is removed from the configuration is sent as an empty string to clear it.
+func MapReadmeUpdate(plan, state types.String) *string {
+	if plan.IsNull() && !state.IsNull() {
+		empty := ""
+		return &empty
+	}
+
+	return plan.ValueStringPointer()
+}
+
 func (r *FederatedGraphResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
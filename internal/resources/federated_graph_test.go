@@ -62,3 +62,39 @@ func TestMapLabelMatchersFromNative(t *testing.T) {
 		})
 	}
 }
+
+func TestMapReadmeUpdate(t *testing.T) {
+	empty := ""
+	readme := "# Graph"
+
+	tests := []struct {
+		name     string
+		plan     types.String
+		state    types.String
+		expected *string
+	}{
+		{
+			name:     "Set",
+			plan:     types.StringValue(readme),
+			state:    types.StringNull(),
+			expected: &readme,
+		},
+		{
+			name:     "Removed",
+			plan:     types.StringNull(),
+			state:    types.StringValue(readme),
+			expected: &empty,
+		},
+		{
+			name:  "NeverSet",
+			plan:  types.StringNull(),
+			state: types.StringNull(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, MapReadmeUpdate(tt.plan, tt.state))
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &FederatedSubgraphResource{}
 var _ resource.ResourceWithImportState = &FederatedSubgraphResource{}
+var _ resource.ResourceWithValidateConfig = &FederatedSubgraphResource{}
 
 func NewFederatedSubgraphResource() resource.Resource {
 	return &FederatedSubgraphResource{}
@@ -45,6 +46,8 @@ type FederatedSubgraphModel struct {
 	Labels               types.Map    `tfsdk:"labels"`
 	IsEventDrivenGraph   types.Bool   `tfsdk:"is_event_driven_graph"`
 	IsFeatureSubgraph    types.Bool   `tfsdk:"is_feature_subgraph"`
+	BaseSubgraphName     types.String `tfsdk:"base_subgraph_name"`
+	Readme               types.String `tfsdk:"readme"`
 }
 
 func (r *FederatedSubgraphResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -107,11 +110,10 @@ func (r *FederatedSubgraphResource) Schema(ctx context.Context, req resource.Sch
 				MarkdownDescription: "The labels to apply to the subgraph.",
 				Optional:            true,
 			},
-			//Readme cannot be implemented because the get endpoint does not return it, so we don't know the actual state
-			//"readme": schema.StringAttribute{
-			//	MarkdownDescription: "The markdown text which describes the subgraph",
-			//	Optional:            true,
-			//},
+			"readme": schema.StringAttribute{
+				MarkdownDescription: "The markdown text which describes the subgraph. Use the `file` function to read it from a file.",
+				Optional:            true,
+			},
 			"is_event_driven_graph": schema.BoolAttribute{
 				MarkdownDescription: "Set whether the subgraph is an Event-Driven Graph (EDG). Errors will be returned for the inclusion of most other parameters if the subgraph is an Event-Driven Graph.",
 				Optional:            true,
@@ -124,10 +126,46 @@ func (r *FederatedSubgraphResource) Schema(ctx context.Context, req resource.Sch
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"base_subgraph_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the subgraph which the feature subgraph replaces. Required if the subgraph is a feature subgraph.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 		},
 	}
 }
 
+func (r *FederatedSubgraphResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FederatedSubgraphModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// We can only validate the combination once both values are known.
+	if data.IsFeatureSubgraph.IsUnknown() || data.BaseSubgraphName.IsUnknown() {
+		return
+	}
+
+	if data.IsFeatureSubgraph.ValueBool() && data.BaseSubgraphName.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_subgraph_name"),
+			"Missing base subgraph name",
+			"base_subgraph_name must be set when is_feature_subgraph is true.",
+		)
+	}
+
+	if !data.IsFeatureSubgraph.ValueBool() && !data.BaseSubgraphName.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_subgraph_name"),
+			"Invalid base subgraph name",
+			"base_subgraph_name can only be set when is_feature_subgraph is true.",
+		)
+	}
+}
+
 func (r *FederatedSubgraphResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -238,6 +276,8 @@ func (r *FederatedSubgraphResource) Create(ctx context.Context, req resource.Cre
 			Labels:               MapLabelsToNative(labels),
 			IsEventDrivenGraph:   plan.IsEventDrivenGraph.ValueBoolPointer(),
 			IsFeatureSubgraph:    plan.IsFeatureSubgraph.ValueBoolPointer(),
+			BaseSubgraphName:     plan.BaseSubgraphName.ValueStringPointer(),
+			Readme:               plan.Readme.ValueStringPointer(),
 		},
 	})
 	if err != nil {
@@ -337,6 +377,11 @@ func (r *FederatedSubgraphResource) Read(ctx context.Context, req resource.ReadR
 				subscriptionUrl = &n.SubscriptionUrl
 			}
 
+			var readme *string
+			if n.Readme != nil && *(n.Readme) != "" {
+				readme = n.Readme
+			}
+
 			labels, diags := types.MapValueFrom(ctx, types.StringType, MapLabelsFromNative(n.Labels))
 			if diags.HasError() {
 				resp.Diagnostics.Append(diags...)
@@ -351,9 +396,11 @@ func (r *FederatedSubgraphResource) Read(ctx context.Context, req resource.ReadR
 				SubscriptionUrl:      types.StringPointerValue(subscriptionUrl),
 				IsEventDrivenGraph:   types.BoolValue(n.IsEventDrivenGraph),
 				IsFeatureSubgraph:    types.BoolValue(n.IsFeatureSubgraph),
+				BaseSubgraphName:     types.StringPointerValue(n.BaseSubgraphName),
 				SubscriptionProtocol: types.StringValue(n.SubscriptionProtocol),
 				WebsocketSubprotocol: types.StringValue(n.WebsocketSubprotocol),
 				Labels:               labels,
+				Readme:               types.StringPointerValue(readme),
 			}
 			continue
 		}
@@ -480,6 +527,13 @@ func (r *FederatedSubgraphResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	if !plan.Readme.Equal(state.Readme) {
+		updateReadme(ctx, r.client, plan.Name.ValueString(), plan.Namespace.ValueString(), plan.Readme, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
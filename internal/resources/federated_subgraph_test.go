@@ -1,12 +1,16 @@
 package resources
 
 import (
+	"context"
 	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
 	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
 	"testing"
 
 	_ "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -166,3 +170,73 @@ func TestMapLabelsFromNative(t *testing.T) {
 		})
 	}
 }
+
+func TestFederatedSubgraphValidateConfig(t *testing.T) {
+	tests := []struct {
+		name              string
+		isFeatureSubgraph tftypes.Value
+		baseSubgraphName  tftypes.Value
+		expectError       bool
+	}{
+		{
+			name:              "Subgraph",
+			isFeatureSubgraph: tftypes.NewValue(tftypes.Bool, nil),
+			baseSubgraphName:  tftypes.NewValue(tftypes.String, nil),
+			expectError:       false,
+		},
+		{
+			name:              "FeatureSubgraph",
+			isFeatureSubgraph: tftypes.NewValue(tftypes.Bool, true),
+			baseSubgraphName:  tftypes.NewValue(tftypes.String, "products"),
+			expectError:       false,
+		},
+		{
+			name:              "FeatureSubgraphWithoutBase",
+			isFeatureSubgraph: tftypes.NewValue(tftypes.Bool, true),
+			baseSubgraphName:  tftypes.NewValue(tftypes.String, nil),
+			expectError:       true,
+		},
+		{
+			name:              "BaseWithoutFeatureSubgraph",
+			isFeatureSubgraph: tftypes.NewValue(tftypes.Bool, false),
+			baseSubgraphName:  tftypes.NewValue(tftypes.String, "products"),
+			expectError:       true,
+		},
+		{
+			name:              "UnknownBase",
+			isFeatureSubgraph: tftypes.NewValue(tftypes.Bool, true),
+			baseSubgraphName:  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expectError:       false,
+		},
+	}
+
+	ctx := context.Background()
+	r := &FederatedSubgraphResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for name, attrType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attrType, nil)
+			}
+			values["name"] = tftypes.NewValue(tftypes.String, "products-feature")
+			values["is_feature_subgraph"] = tt.isFeatureSubgraph
+			values["base_subgraph_name"] = tt.baseSubgraphName
+
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(objectType, values),
+				},
+			}
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, req, &resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError())
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package resources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IntegrationResource{}
+var _ resource.ResourceWithImportState = &IntegrationResource{}
+
+func NewIntegrationResource() resource.Resource {
+	return &IntegrationResource{}
+}
+
+// IntegrationResource defines the resource implementation.
+type IntegrationResource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// IntegrationModel describes the resource data model.
+type IntegrationModel struct {
+	Id                          types.String `tfsdk:"id"`
+	Name                        types.String `tfsdk:"name"`
+	Type                        types.String `tfsdk:"type"`
+	Code                        types.String `tfsdk:"code"`
+	Endpoint                    types.String `tfsdk:"endpoint"`
+	FederatedGraphSchemaUpdated *EventGraphs `tfsdk:"federated_graph_schema_updated"`
+	MonographSchemaUpdated      *EventGraphs `tfsdk:"monograph_schema_updated"`
+}
+
+func (r *IntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+func (r *IntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Notification integration, such as a Slack channel. Integrations are usually created once through the OAuth flow in the studio and then imported by their id.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the integration.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the integration. The supported type is slack. Defaults to `slack`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("slack"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("slack"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"code": schema.StringAttribute{
+				MarkdownDescription: "The OAuth code returned by the integration provider. It is only used when the integration is created.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "The endpoint the notifications are sent to, as configured by the integration provider.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"federated_graph_schema_updated": eventGraphsAttribute("Send a notification when the schema of one of the federated graphs is updated."),
+			"monograph_schema_updated":       eventGraphsAttribute("Send a notification when the schema of one of the monographs is updated."),
+		},
+	}
+}
+
+func (r *IntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *IntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	events, meta, diags := MapEventsToNative(ctx, plan.FederatedGraphSchemaUpdated, plan.MonographSchemaUpdated)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rc, err := r.client.CreateIntegration(ctx, &connect.Request[platformv1.CreateIntegrationRequest]{
+		Msg: &platformv1.CreateIntegrationRequest{
+			Type:       plan.Type.ValueString(),
+			Name:       plan.Name.ValueString(),
+			Code:       plan.Code.ValueString(),
+			Events:     events,
+			EventsMeta: meta,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating integration", err.Error())
+		return
+	}
+
+	if rc.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error creating integration", rc.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	// We fetch the integration list to get the requested integration, as the create response does not contain its id.
+	integration, err := r.findIntegration(ctx, func(i *platformv1.Integration) bool {
+		return i.Name == plan.Name.ValueString()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading integration", err.Error())
+		return
+	}
+
+	if integration == nil {
+		resp.Diagnostics.AddError("Error reading integration", "integration not found")
+		return
+	}
+
+	plan.Id = types.StringValue(integration.Id)
+	plan.Endpoint = types.StringValue(integration.GetIntegrationConfig().GetSlackIntegrationConfig().GetEndpoint())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *IntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IntegrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integration, err := r.findIntegration(ctx, func(i *platformv1.Integration) bool {
+		return i.Id == data.Id.ValueString()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading integration", err.Error())
+		return
+	}
+
+	// The integration has been removed outside of terraform.
+	if integration == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	federatedGraphSchemaUpdated, monographSchemaUpdated, diags := MapEventsFromNative(ctx, integration.Events, integration.EventsMeta)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := &IntegrationModel{
+		Id:                          types.StringValue(integration.Id),
+		Name:                        types.StringValue(integration.Name),
+		Type:                        types.StringValue(integration.Type),
+		Endpoint:                    types.StringValue(integration.GetIntegrationConfig().GetSlackIntegrationConfig().GetEndpoint()),
+		FederatedGraphSchemaUpdated: federatedGraphSchemaUpdated,
+		MonographSchemaUpdated:      monographSchemaUpdated,
+		// The code can only be used once and is never returned by the API, so we keep the value from the state.
+		Code: data.Code,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &current)...)
+}
+
+func (r *IntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan IntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state IntegrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	events, meta, diags := MapEventsToNative(ctx, plan.FederatedGraphSchemaUpdated, plan.MonographSchemaUpdated)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ru, err := r.client.UpdateIntegrationConfig(ctx, &connect.Request[platformv1.UpdateIntegrationConfigRequest]{
+		Msg: &platformv1.UpdateIntegrationConfigRequest{
+			Id:         state.Id.ValueString(),
+			Endpoint:   state.Endpoint.ValueString(),
+			Events:     events,
+			EventsMeta: meta,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating integration", err.Error())
+		return
+	}
+
+	if ru.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error updating integration", ru.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *IntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IntegrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rd, err := r.client.DeleteIntegration(ctx, &connect.Request[platformv1.DeleteIntegrationRequest]{
+		Msg: &platformv1.DeleteIntegrationRequest{
+			Id: data.Id.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting integration", err.Error())
+		return
+	}
+
+	if rd.Msg.GetResponse().Code != common.EnumStatusCode_OK && rd.Msg.GetResponse().Code != common.EnumStatusCode_ERR_NOT_FOUND {
+		resp.Diagnostics.AddError("Error deleting integration", rd.Msg.GetResponse().GetDetails())
+		return
+	}
+}
+
+func (r *IntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// findIntegration returns the first integration of the organization matching the predicate, or nil if there is none.
+func (r *IntegrationResource) findIntegration(ctx context.Context, match func(*platformv1.Integration) bool) (*platformv1.Integration, error) {
+	ri, err := r.client.GetOrganizationIntegrations(ctx, &connect.Request[platformv1.GetOrganizationIntegrationsRequest]{
+		Msg: &platformv1.GetOrganizationIntegrationsRequest{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if ri.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		return nil, fmt.Errorf("error fetching integration list: %s", ri.Msg.GetResponse().GetDetails())
+	}
+
+	for _, i := range ri.Msg.Integrations {
+		if match(i) {
+			return i, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,465 @@
+package resources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MonographResource{}
+var _ resource.ResourceWithImportState = &MonographResource{}
+
+func NewMonographResource() resource.Resource {
+	return &MonographResource{}
+}
+
+// MonographResource defines the resource implementation.
+type MonographResource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// MonographModel describes the resource data model.
+type MonographModel struct {
+	Id                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Namespace              types.String `tfsdk:"namespace"`
+	RoutingUrl             types.String `tfsdk:"routing_url"`
+	GraphUrl               types.String `tfsdk:"graph_url"`
+	Schema                 types.String `tfsdk:"schema"`
+	SubscriptionUrl        types.String `tfsdk:"subscription_url"`
+	SubscriptionProtocol   types.String `tfsdk:"subscription_protocol"`
+	WebsocketSubprotocol   types.String `tfsdk:"websocket_subprotocol"`
+	AdmissionWebhookUrl    types.String `tfsdk:"admission_webhook_url"`
+	AdmissionWebhookSecret types.String `tfsdk:"admission_webhook_secret"`
+	Readme                 types.String `tfsdk:"readme"`
+}
+
+func (r *MonographResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monograph"
+}
+
+func (r *MonographResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Monograph, a non-federated GraphQL API served through the router.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the monograph to create. It is used to uniquely identify your monograph.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The namespace name of the monograph. Defaults to `default`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+			},
+			"routing_url": schema.StringAttribute{
+				MarkdownDescription: "The routing url of your router. This is the url that the router will be accessible at.",
+				Required:            true,
+			},
+			"graph_url": schema.StringAttribute{
+				MarkdownDescription: "The url of your GraphQL server that is accessible from the router.",
+				Required:            true,
+			},
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "The schema to publish to the monograph. This should be the full schema in SDL format.",
+				Required:            true,
+			},
+			"subscription_url": schema.StringAttribute{
+				MarkdownDescription: "The url used for subscriptions. If empty, it defaults to the same url used for routing.",
+				Optional:            true,
+			},
+			"subscription_protocol": schema.StringAttribute{
+				MarkdownDescription: "The protocol to use when subscribing to the graph. The supported protocols are ws, sse, and sse_post.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("ws"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("ws", "sse", "sse_post"),
+				},
+			},
+			"websocket_subprotocol": schema.StringAttribute{
+				MarkdownDescription: "The subprotocol to use when subscribing to the graph. The supported protocols are auto, graphql-ws, and graphql-transport-ws. Should be used only if the subscription protocol is ws. For more information see https://cosmo-docs.wundergraph.com/router/subscriptions/websocket-subprotocols.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("auto"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "graphql-ws", "graphql-transport-ws"),
+				},
+			},
+			"admission_webhook_url": schema.StringAttribute{
+				MarkdownDescription: "The admission webhook url. This is the url that the controlplane will use to implement admission control for the monograph.",
+				Optional:            true,
+			},
+			"admission_webhook_secret": schema.StringAttribute{
+				MarkdownDescription: "The admission webhook secret is used to sign requests to the webhook url.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"readme": schema.StringAttribute{
+				MarkdownDescription: "The markdown text which describes the monograph.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *MonographResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MonographResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *MonographModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p, err := MapSubscriptionProtocol(plan.SubscriptionProtocol)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating monograph", err.Error())
+		return
+	}
+
+	w, err := MapWebSocketSubprotocol(plan.WebsocketSubprotocol)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating monograph", err.Error())
+		return
+	}
+
+	//We first create the monograph
+	rc, err := r.client.CreateMonograph(ctx, &connect.Request[platformv1.CreateMonographRequest]{
+		Msg: &platformv1.CreateMonographRequest{
+			Name:                   plan.Name.ValueString(),
+			Namespace:              plan.Namespace.ValueString(),
+			RoutingUrl:             plan.RoutingUrl.ValueString(),
+			GraphUrl:               plan.GraphUrl.ValueString(),
+			SubscriptionUrl:        plan.SubscriptionUrl.ValueStringPointer(),
+			SubscriptionProtocol:   p,
+			WebsocketSubprotocol:   w,
+			Readme:                 plan.Readme.ValueStringPointer(),
+			AdmissionWebhookURL:    plan.AdmissionWebhookUrl.ValueString(),
+			AdmissionWebhookSecret: plan.AdmissionWebhookSecret.ValueStringPointer(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating monograph", err.Error())
+		return
+	}
+
+	if rc.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error creating monograph", rc.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	// We fetch the FederatedGraph list to get the requested monograph, as we don't have a direct read endpoint.
+	ns, err := r.client.GetFederatedGraphs(ctx, &connect.Request[platformv1.GetFederatedGraphsRequest]{
+		Msg: &platformv1.GetFederatedGraphsRequest{
+			Namespace: plan.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading monograph", err.Error())
+		return
+	}
+
+	var isFound bool
+	for _, n := range ns.Msg.Graphs {
+		if n.Name == plan.Name.ValueString() {
+			plan.Id = types.StringValue(n.Id)
+			isFound = true
+			continue
+		}
+	}
+
+	if !isFound {
+		resp.Diagnostics.AddError("Error reading monograph", "monograph not found")
+		return
+	}
+
+	// The id is stored before publishing, so a failing publish does not leave an untracked monograph behind.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	r.publish(ctx, plan, &resp.Diagnostics)
+}
+
+func (r *MonographResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *MonographModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// We fetch the FederatedGraph list to get the requested monograph, as we don't have a direct read endpoint.
+	ns, err := r.client.GetFederatedGraphs(ctx, &connect.Request[platformv1.GetFederatedGraphsRequest]{
+		Msg: &platformv1.GetFederatedGraphsRequest{
+			Namespace: data.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading monograph", err.Error())
+		return
+	}
+
+	if ns.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error fetching monograph list", ns.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	var graph *platformv1.FederatedGraph
+	for _, n := range ns.Msg.Graphs {
+		if n.Id == data.Id.ValueString() {
+			graph = n
+			continue
+		}
+	}
+
+	if graph == nil {
+		resp.Diagnostics.AddError("Error reading monograph", "monograph not found")
+		return
+	}
+
+	// The graph url and subscription settings are stored on the single subgraph backing the monograph.
+	rg, err := r.client.GetFederatedGraphByName(ctx, &connect.Request[platformv1.GetFederatedGraphByNameRequest]{
+		Msg: &platformv1.GetFederatedGraphByNameRequest{
+			Name:      graph.Name,
+			Namespace: graph.Namespace,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading monograph", err.Error())
+		return
+	}
+
+	if rg.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error reading monograph", rg.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	if len(rg.Msg.Subgraphs) == 0 {
+		resp.Diagnostics.AddError("Error reading monograph", "monograph has no graph")
+		return
+	}
+	subgraph := rg.Msg.Subgraphs[0]
+
+	var admissionWebhookUrl *string
+	if graph.AdmissionWebhookUrl != nil && *(graph.AdmissionWebhookUrl) != "" {
+		admissionWebhookUrl = graph.AdmissionWebhookUrl
+	}
+
+	// We need to check if the subscription url is empty, as it is optional. If an empty string is returned we assume it is nil.
+	var subscriptionUrl *string
+	if subgraph.SubscriptionUrl != "" {
+		subscriptionUrl = &subgraph.SubscriptionUrl
+	}
+
+	var readme *string
+	if graph.Readme != nil && *(graph.Readme) != "" {
+		readme = graph.Readme
+	}
+
+	current := &MonographModel{
+		Id:                   types.StringValue(graph.Id),
+		Name:                 types.StringValue(graph.Name),
+		Namespace:            types.StringValue(graph.Namespace),
+		RoutingUrl:           types.StringValue(graph.RoutingURL),
+		GraphUrl:             types.StringValue(subgraph.RoutingURL),
+		SubscriptionUrl:      types.StringPointerValue(subscriptionUrl),
+		SubscriptionProtocol: types.StringValue(subgraph.SubscriptionProtocol),
+		WebsocketSubprotocol: types.StringValue(subgraph.WebsocketSubprotocol),
+		AdmissionWebhookUrl:  types.StringPointerValue(admissionWebhookUrl),
+		Readme:               types.StringPointerValue(readme),
+		// The secret is never returned by the API, so we keep the value from the state.
+		AdmissionWebhookSecret: data.AdmissionWebhookSecret,
+	}
+
+	sdl, err := r.client.GetLatestSubgraphSDL(ctx, &connect.Request[platformv1.GetLatestSubgraphSDLRequest]{
+		Msg: &platformv1.GetLatestSubgraphSDLRequest{
+			Name:      subgraph.Name,
+			Namespace: subgraph.Namespace,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching sdl", err.Error())
+		return
+	}
+
+	if sdl.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error fetching SDL", sdl.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	current.Schema = types.StringPointerValue(sdl.Msg.Sdl)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &current)...)
+}
+
+func (r *MonographResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan MonographModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state MonographModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//First we check if the namespace needs to be moved, as the other calls address the monograph by its new namespace
+	if !plan.Namespace.Equal(state.Namespace) {
+		rm, err := r.client.MoveMonograph(ctx, &connect.Request[platformv1.MoveGraphRequest]{
+			Msg: &platformv1.MoveGraphRequest{
+				Name:         state.Name.ValueString(),
+				Namespace:    state.Namespace.ValueString(),
+				NewNamespace: plan.Namespace.ValueString(),
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error moving namespace", err.Error())
+			return
+		}
+
+		if rm.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+			resp.Diagnostics.AddError("Error updating monograph", rm.Msg.GetResponse().GetDetails())
+			return
+		}
+	}
+
+	p, err := MapSubscriptionProtocol(plan.SubscriptionProtocol)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating monograph", err.Error())
+		return
+	}
+
+	w, err := MapWebSocketSubprotocol(plan.WebsocketSubprotocol)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating monograph", err.Error())
+		return
+	}
+
+	ru, err := r.client.UpdateMonograph(ctx, &connect.Request[platformv1.UpdateMonographRequest]{
+		Msg: &platformv1.UpdateMonographRequest{
+			Name:                   plan.Name.ValueString(),
+			Namespace:              plan.Namespace.ValueString(),
+			RoutingUrl:             plan.RoutingUrl.ValueString(),
+			GraphUrl:               plan.GraphUrl.ValueString(),
+			SubscriptionUrl:        plan.SubscriptionUrl.ValueStringPointer(),
+			SubscriptionProtocol:   p,
+			WebsocketSubprotocol:   w,
+			Readme:                 MapReadmeUpdate(plan.Readme, state.Readme),
+			AdmissionWebhookURL:    plan.AdmissionWebhookUrl.ValueStringPointer(),
+			AdmissionWebhookSecret: plan.AdmissionWebhookSecret.ValueStringPointer(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating monograph", err.Error())
+		return
+	}
+
+	if ru.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error updating monograph", ru.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	//Then we update the schema itself
+	if !plan.Schema.Equal(state.Schema) {
+		r.publish(ctx, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *MonographResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *MonographModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rd, err := r.client.DeleteMonograph(ctx, &connect.Request[platformv1.DeleteMonographRequest]{
+		Msg: &platformv1.DeleteMonographRequest{
+			Name:      data.Name.ValueString(),
+			Namespace: data.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting monograph", err.Error())
+		return
+	}
+
+	if rd.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error deleting monograph", rd.Msg.GetResponse().GetDetails())
+		return
+	}
+}
+
+func (r *MonographResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// publish uploads the schema of the monograph. Composition and deployment errors are reported as warnings, as the
+// schema itself has been stored.
+func (r *MonographResource) publish(ctx context.Context, plan *MonographModel, diags *diag.Diagnostics) {
+	rp, err := r.client.PublishMonograph(ctx, &connect.Request[platformv1.PublishMonographRequest]{
+		Msg: &platformv1.PublishMonographRequest{
+			Name:      plan.Name.ValueString(),
+			Namespace: plan.Namespace.ValueString(),
+			Schema:    plan.Schema.ValueString(),
+		},
+	})
+	if err != nil {
+		diags.AddError("Error publishing schema", err.Error())
+		return
+	}
+
+	AddCompositionDiagnostics(diags, "publishing monograph", rp.Msg.CompositionErrors, rp.Msg.DeploymentErrors)
+
+	code := rp.Msg.GetResponse().Code
+	if code != common.EnumStatusCode_OK && !IsCompositionFailure(code) {
+		diags.AddError("Error publishing schema", rp.Msg.GetResponse().GetDetails())
+	}
+}
@@ -0,0 +1,283 @@
+package resources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+	"sort"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NamespaceLintConfigResource{}
+var _ resource.ResourceWithImportState = &NamespaceLintConfigResource{}
+
+func NewNamespaceLintConfigResource() resource.Resource {
+	return &NamespaceLintConfigResource{}
+}
+
+// NamespaceLintConfigResource defines the resource implementation.
+type NamespaceLintConfigResource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// NamespaceLintConfigModel describes the resource data model.
+type NamespaceLintConfigModel struct {
+	Id        types.String `tfsdk:"id"`
+	Namespace types.String `tfsdk:"namespace"`
+	Rules     types.Map    `tfsdk:"rules"`
+}
+
+func (r *NamespaceLintConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespace_lint_config"
+}
+
+func (r *NamespaceLintConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lint configuration of a namespace. Linting is enabled for the namespace while the resource exists.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, equal to the namespace name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The name of the namespace to enable linting for.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The lint rules to apply, mapped to their severity. The supported severities are warn and error. For the available rules see https://cosmo-docs.wundergraph.com/studio/schema-linting.",
+				Required:            true,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf("warn", "error")),
+				},
+			},
+		},
+	}
+}
+
+func (r *NamespaceLintConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func MapLintRulesToNative(rules map[string]string) ([]*platformv1.LintConfig, error) {
+	names := make([]string, 0, len(rules))
+	for k := range rules {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var c []*platformv1.LintConfig
+	for _, name := range names {
+		severity, ok := platformv1.LintSeverity_value[rules[name]]
+		if !ok {
+			return nil, fmt.Errorf("unsupported severity for rule %s: %s", name, rules[name])
+		}
+
+		c = append(c, &platformv1.LintConfig{
+			RuleName:      name,
+			SeverityLevel: platformv1.LintSeverity(severity),
+		})
+	}
+
+	return c, nil
+}
+
+func MapLintRulesFromNative(configs []*platformv1.LintConfig) map[string]string {
+	r := make(map[string]string)
+	for _, v := range configs {
+		r[v.RuleName] = v.SeverityLevel.String()
+	}
+
+	return r
+}
+
+func (r *NamespaceLintConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *NamespaceLintConfigModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	re, err := r.client.EnableLintingForTheNamespace(ctx, &connect.Request[platformv1.EnableLintingForTheNamespaceRequest]{
+		Msg: &platformv1.EnableLintingForTheNamespaceRequest{
+			Namespace:     plan.Namespace.ValueString(),
+			EnableLinting: true,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error enabling linting", err.Error())
+		return
+	}
+
+	if re.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error enabling linting", re.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	r.configureRules(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = plan.Namespace
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *NamespaceLintConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *NamespaceLintConfigModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The namespace is not known yet after an import, so we fall back to the id.
+	namespace := data.Namespace.ValueString()
+	if namespace == "" {
+		namespace = data.Id.ValueString()
+	}
+
+	rg, err := r.client.GetNamespaceLintConfig(ctx, &connect.Request[platformv1.GetNamespaceLintConfigRequest]{
+		Msg: &platformv1.GetNamespaceLintConfigRequest{
+			Namespace: namespace,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading lint config", err.Error())
+		return
+	}
+
+	if rg.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error reading lint config", rg.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	// Linting has been disabled outside of terraform, so the configuration has to be applied again.
+	if !rg.Msg.LinterEnabled {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	rules, diags := types.MapValueFrom(ctx, types.StringType, MapLintRulesFromNative(rg.Msg.Configs))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := &NamespaceLintConfigModel{
+		Id:        types.StringValue(namespace),
+		Namespace: types.StringValue(namespace),
+		Rules:     rules,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &current)...)
+}
+
+func (r *NamespaceLintConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan NamespaceLintConfigModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.configureRules(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *NamespaceLintConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *NamespaceLintConfigModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	re, err := r.client.EnableLintingForTheNamespace(ctx, &connect.Request[platformv1.EnableLintingForTheNamespaceRequest]{
+		Msg: &platformv1.EnableLintingForTheNamespaceRequest{
+			Namespace:     data.Namespace.ValueString(),
+			EnableLinting: false,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error disabling linting", err.Error())
+		return
+	}
+
+	if re.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error disabling linting", re.Msg.GetResponse().GetDetails())
+		return
+	}
+}
+
+// configureRules replaces the lint rules of the namespace with the planned rules.
+func (r *NamespaceLintConfigResource) configureRules(ctx context.Context, plan *NamespaceLintConfigModel, diags *diag.Diagnostics) {
+	var rules map[string]string
+	diags.Append(plan.Rules.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	configs, err := MapLintRulesToNative(rules)
+	if err != nil {
+		diags.AddError("Error configuring lint rules", err.Error())
+		return
+	}
+
+	rc, err := r.client.ConfigureNamespaceLintConfig(ctx, &connect.Request[platformv1.ConfigureNamespaceLintConfigRequest]{
+		Msg: &platformv1.ConfigureNamespaceLintConfigRequest{
+			Namespace: plan.Namespace.ValueString(),
+			Configs:   configs,
+		},
+	})
+	if err != nil {
+		diags.AddError("Error configuring lint rules", err.Error())
+		return
+	}
+
+	if rc.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		diags.AddError("Error configuring lint rules", rc.Msg.GetResponse().GetDetails())
+		return
+	}
+}
+
+func (r *NamespaceLintConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,78 @@
+package resources
+
+import (
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapLintRulesToNative(t *testing.T) {
+	tests := []struct {
+		name        string
+		rules       map[string]string
+		expected    []*platformv1.LintConfig
+		expectError bool
+	}{
+		{
+			name:  "ValidInput",
+			rules: map[string]string{"FIELD_NAMES_SHOULD_BE_CAMEL_CASE": "error", "ALPHABETICALLY_SORT_ENUM_VALUES": "warn"},
+			expected: []*platformv1.LintConfig{
+				{RuleName: "ALPHABETICALLY_SORT_ENUM_VALUES", SeverityLevel: platformv1.LintSeverity_warn},
+				{RuleName: "FIELD_NAMES_SHOULD_BE_CAMEL_CASE", SeverityLevel: platformv1.LintSeverity_error},
+			},
+		},
+		{
+			name:        "InvalidSeverity",
+			rules:       map[string]string{"FIELD_NAMES_SHOULD_BE_CAMEL_CASE": "fatal"},
+			expectError: true,
+		},
+		{
+			name:  "EmptyInput",
+			rules: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := MapLintRulesToNative(tt.rules)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestMapLintRulesFromNative(t *testing.T) {
+	tests := []struct {
+		name     string
+		configs  []*platformv1.LintConfig
+		expected map[string]string
+	}{
+		{
+			name: "ValidInput",
+			configs: []*platformv1.LintConfig{
+				{RuleName: "ALPHABETICALLY_SORT_ENUM_VALUES", SeverityLevel: platformv1.LintSeverity_warn},
+				{RuleName: "FIELD_NAMES_SHOULD_BE_CAMEL_CASE", SeverityLevel: platformv1.LintSeverity_error},
+			},
+			expected: map[string]string{"FIELD_NAMES_SHOULD_BE_CAMEL_CASE": "error", "ALPHABETICALLY_SORT_ENUM_VALUES": "warn"},
+		},
+		{
+			name:     "EmptyInput",
+			configs:  []*platformv1.LintConfig{},
+			expected: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := MapLintRulesFromNative(tt.configs)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package resources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+	"net/url"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OIDCProviderResource{}
+
+func NewOIDCProviderResource() resource.Resource {
+	return &OIDCProviderResource{}
+}
+
+// OIDCProviderResource defines the resource implementation.
+type OIDCProviderResource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// OIDCProviderModel describes the resource data model.
+type OIDCProviderModel struct {
+	Id                types.String  `tfsdk:"id"`
+	Name              types.String  `tfsdk:"name"`
+	DiscoveryEndpoint types.String  `tfsdk:"discovery_endpoint"`
+	ClientId          types.String  `tfsdk:"client_id"`
+	ClientSecret      types.String  `tfsdk:"client_secret"`
+	Mappers           []GroupMapper `tfsdk:"mappers"`
+	LoginUrl          types.String  `tfsdk:"login_url"`
+	SignInUrl         types.String  `tfsdk:"sign_in_url"`
+	SignOutUrl        types.String  `tfsdk:"sign_out_url"`
+}
+
+type GroupMapper struct {
+	Role     types.String `tfsdk:"role"`
+	SsoGroup types.String `tfsdk:"sso_group"`
+}
+
+func (r *OIDCProviderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oidc_provider"
+}
+
+func (r *OIDCProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "OIDC provider used for single sign-on into the organization. An organization can only have a single OIDC provider, and it cannot be updated in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the OIDC provider.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"discovery_endpoint": schema.StringAttribute{
+				MarkdownDescription: "The OIDC discovery endpoint of the identity provider, for example `https://idp.example.com/.well-known/openid-configuration`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The client id of the application registered at the identity provider.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "The client secret of the application registered at the identity provider.",
+				Required:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mappers": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The organization role assigned to the members of the group.",
+						},
+						"sso_group": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the group at the identity provider.",
+						},
+					},
+				},
+				MarkdownDescription: "The mappers assign organization roles to the members of groups at the identity provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"login_url": schema.StringAttribute{
+				MarkdownDescription: "The url users use to log in to the organization through the OIDC provider.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sign_in_url": schema.StringAttribute{
+				MarkdownDescription: "The sign-in redirect url to register at the identity provider.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sign_out_url": schema.StringAttribute{
+				MarkdownDescription: "The sign-out redirect url to register at the identity provider.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OIDCProviderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func MapGroupMappersToNative(mappers []GroupMapper) []*platformv1.GroupMapper {
+	var m []*platformv1.GroupMapper
+	for _, v := range mappers {
+		m = append(m, &platformv1.GroupMapper{
+			Role:     v.Role.ValueString(),
+			SsoGroup: v.SsoGroup.ValueString(),
+		})
+	}
+
+	return m
+}
+
+func (r *OIDCProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *OIDCProviderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rc, err := r.client.CreateOIDCProvider(ctx, &connect.Request[platformv1.CreateOIDCProviderRequest]{
+		Msg: &platformv1.CreateOIDCProviderRequest{
+			Name:              plan.Name.ValueString(),
+			DiscoveryEndpoint: plan.DiscoveryEndpoint.ValueString(),
+			ClientID:          plan.ClientId.ValueString(),
+			ClientSecrect:     plan.ClientSecret.ValueString(),
+			Mappers:           MapGroupMappersToNative(plan.Mappers),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating OIDC provider", err.Error())
+		return
+	}
+
+	if rc.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error creating OIDC provider", rc.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	// An organization has a single OIDC provider, which has no id of its own.
+	plan.Id = plan.Name
+	plan.LoginUrl = types.StringValue(rc.Msg.LoginURL)
+	plan.SignInUrl = types.StringValue(rc.Msg.SignInURL)
+	plan.SignOutUrl = types.StringValue(rc.Msg.SignOutURL)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// MapDiscoveryEndpointFromNative returns the discovery endpoint of the provider. The API returns either the configured
+// endpoint or only its host, so the configured endpoint is kept as long as it points to the same identity provider.
+func MapDiscoveryEndpointFromNative(current types.String, endpoint string) types.String {
+	if endpoint == "" || current.ValueString() == endpoint {
+		return current
+	}
+
+	if u, err := url.Parse(current.ValueString()); err == nil && u.Host == endpoint {
+		return current
+	}
+
+	return types.StringValue(endpoint)
+}
+
+func (r *OIDCProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *OIDCProviderModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rg, err := r.client.GetOIDCProvider(ctx, &connect.Request[platformv1.GetOIDCProviderRequest]{
+		Msg: &platformv1.GetOIDCProviderRequest{},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading OIDC provider", err.Error())
+		return
+	}
+
+	// The provider has been removed outside of terraform, so it has to be created again.
+	if rg.Msg.GetResponse().Code == common.EnumStatusCode_ERR_NOT_FOUND {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if rg.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error reading OIDC provider", rg.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	// The client credentials and mappers are not returned by the API, so we keep the values from the state.
+	data.Name = types.StringValue(rg.Msg.Name)
+	data.DiscoveryEndpoint = MapDiscoveryEndpointFromNative(data.DiscoveryEndpoint, rg.Msg.Endpoint)
+	data.LoginUrl = types.StringValue(rg.Msg.LoginURL)
+	data.SignInUrl = types.StringValue(rg.Msg.SignInRedirectURL)
+	data.SignOutUrl = types.StringValue(rg.Msg.SignOutRedirectURL)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OIDCProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require a replacement, so there is nothing to update in place.
+	var plan OIDCProviderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OIDCProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	rd, err := r.client.DeleteOIDCProvider(ctx, &connect.Request[platformv1.DeleteOIDCProviderRequest]{
+		Msg: &platformv1.DeleteOIDCProviderRequest{},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting OIDC provider", err.Error())
+		return
+	}
+
+	if rd.Msg.GetResponse().Code != common.EnumStatusCode_OK && rd.Msg.GetResponse().Code != common.EnumStatusCode_ERR_NOT_FOUND {
+		resp.Diagnostics.AddError("Error deleting OIDC provider", rd.Msg.GetResponse().GetDetails())
+		return
+	}
+}
@@ -0,0 +1,82 @@
+package resources
+
+import (
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapGroupMappersToNative(t *testing.T) {
+	tests := []struct {
+		name     string
+		mappers  []GroupMapper
+		expected []*platformv1.GroupMapper
+	}{
+		{
+			name: "ValidInput",
+			mappers: []GroupMapper{
+				{Role: types.StringValue("admin"), SsoGroup: types.StringValue("platform")},
+				{Role: types.StringValue("developer"), SsoGroup: types.StringValue("engineering")},
+			},
+			expected: []*platformv1.GroupMapper{
+				{Role: "admin", SsoGroup: "platform"},
+				{Role: "developer", SsoGroup: "engineering"},
+			},
+		},
+		{
+			name:    "EmptyInput",
+			mappers: []GroupMapper{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := MapGroupMappersToNative(tt.mappers)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestMapDiscoveryEndpointFromNative(t *testing.T) {
+	configured := types.StringValue("https://idp.example.com/.well-known/openid-configuration")
+
+	tests := []struct {
+		name     string
+		endpoint string
+		expected types.String
+	}{
+		{
+			name:     "SameEndpoint",
+			endpoint: "https://idp.example.com/.well-known/openid-configuration",
+			expected: configured,
+		},
+		{
+			name:     "SameHost",
+			endpoint: "idp.example.com",
+			expected: configured,
+		},
+		{
+			name:     "NotReturned",
+			endpoint: "",
+			expected: configured,
+		},
+		{
+			name:     "OtherHost",
+			endpoint: "other.example.com",
+			expected: types.StringValue("other.example.com"),
+		},
+		{
+			name:     "OtherEndpoint",
+			endpoint: "https://other.example.com/.well-known/openid-configuration",
+			expected: types.StringValue("https://other.example.com/.well-known/openid-configuration"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, MapDiscoveryEndpointFromNative(configured, tt.endpoint))
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package resources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OperationIgnoreAllOverrideResource{}
+
+func NewOperationIgnoreAllOverrideResource() resource.Resource {
+	return &OperationIgnoreAllOverrideResource{}
+}
+
+// OperationIgnoreAllOverrideResource defines the resource implementation.
+type OperationIgnoreAllOverrideResource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// OperationIgnoreAllOverrideModel describes the resource data model.
+type OperationIgnoreAllOverrideModel struct {
+	Id            types.String `tfsdk:"id"`
+	GraphName     types.String `tfsdk:"graph_name"`
+	Namespace     types.String `tfsdk:"namespace"`
+	OperationHash types.String `tfsdk:"operation_hash"`
+	OperationName types.String `tfsdk:"operation_name"`
+}
+
+func (r *OperationIgnoreAllOverrideResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operation_ignore_all_override"
+}
+
+func (r *OperationIgnoreAllOverrideResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Override that marks all breaking changes as safe for an operation, so schema checks never fail on it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, equal to the operation hash.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the federated graph the operation belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The namespace of the federated graph. Defaults to `default`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operation_hash": schema.StringAttribute{
+				MarkdownDescription: "The hash of the operation, as shown in the schema check.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operation_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the operation.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OperationIgnoreAllOverrideResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OperationIgnoreAllOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *OperationIgnoreAllOverrideModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rc, err := r.client.CreateOperationIgnoreAllOverride(ctx, &connect.Request[platformv1.CreateOperationIgnoreAllOverrideRequest]{
+		Msg: &platformv1.CreateOperationIgnoreAllOverrideRequest{
+			GraphName:     plan.GraphName.ValueString(),
+			Namespace:     plan.Namespace.ValueString(),
+			OperationHash: plan.OperationHash.ValueString(),
+			OperationName: plan.OperationName.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating operation ignore all override", err.Error())
+		return
+	}
+
+	if rc.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error creating operation ignore all override", rc.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	plan.Id = plan.OperationHash
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OperationIgnoreAllOverrideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *OperationIgnoreAllOverrideModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	override, err := findOperationOverride(ctx, r.client, data.GraphName.ValueString(), data.Namespace.ValueString(), data.OperationHash.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading operation ignore all override", err.Error())
+		return
+	}
+
+	// The override has been removed outside of terraform.
+	if override == nil || !override.HasIgnoreAllOverride {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.OperationName = types.StringValue(override.Name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OperationIgnoreAllOverrideResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require a replacement, so there is nothing to update in place.
+	var plan OperationIgnoreAllOverrideModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OperationIgnoreAllOverrideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *OperationIgnoreAllOverrideModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rr, err := r.client.RemoveOperationIgnoreAllOverride(ctx, &connect.Request[platformv1.RemoveOperationIgnoreAllOverrideRequest]{
+		Msg: &platformv1.RemoveOperationIgnoreAllOverrideRequest{
+			GraphName:     data.GraphName.ValueString(),
+			Namespace:     data.Namespace.ValueString(),
+			OperationHash: data.OperationHash.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting operation ignore all override", err.Error())
+		return
+	}
+
+	if rr.Msg.GetResponse().Code != common.EnumStatusCode_OK && rr.Msg.GetResponse().Code != common.EnumStatusCode_ERR_NOT_FOUND {
+		resp.Diagnostics.AddError("Error deleting operation ignore all override", rr.Msg.GetResponse().GetDetails())
+		return
+	}
+}
@@ -0,0 +1,365 @@
+package resources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OperationOverrideResource{}
+
+func NewOperationOverrideResource() resource.Resource {
+	return &OperationOverrideResource{}
+}
+
+// OperationOverrideResource defines the resource implementation.
+type OperationOverrideResource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// OperationOverrideModel describes the resource data model.
+type OperationOverrideModel struct {
+	Id            types.String     `tfsdk:"id"`
+	GraphName     types.String     `tfsdk:"graph_name"`
+	Namespace     types.String     `tfsdk:"namespace"`
+	OperationHash types.String     `tfsdk:"operation_hash"`
+	OperationName types.String     `tfsdk:"operation_name"`
+	Changes       []OverrideChange `tfsdk:"changes"`
+}
+
+type OverrideChange struct {
+	ChangeType types.String `tfsdk:"change_type"`
+	Path       types.String `tfsdk:"path"`
+}
+
+func (r *OperationOverrideResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operation_override"
+}
+
+func (r *OperationOverrideResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Overrides that mark specific breaking changes as safe for an operation, so schema checks no longer fail on them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, equal to the operation hash.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the federated graph the operation belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The namespace of the federated graph. Defaults to `default`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operation_hash": schema.StringAttribute{
+				MarkdownDescription: "The hash of the operation, as shown in the schema check.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operation_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the operation.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"changes": schema.SetNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"change_type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The type of the breaking change, for example `FIELD_REMOVED`.",
+						},
+						"path": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The schema path of the breaking change, for example `Query.employees`.",
+						},
+					},
+				},
+				MarkdownDescription: "The breaking changes to mark as safe for the operation.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *OperationOverrideResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func MapOverrideChangesToNative(changes []OverrideChange) []*platformv1.OverrideChange {
+	var c []*platformv1.OverrideChange
+	for _, v := range changes {
+		c = append(c, &platformv1.OverrideChange{
+			ChangeType: v.ChangeType.ValueString(),
+			Path:       v.Path.ValueStringPointer(),
+		})
+	}
+
+	return c
+}
+
+func MapOverrideChangesFromNative(changes []*platformv1.OverrideChange) []OverrideChange {
+	var c []OverrideChange
+	for _, v := range changes {
+		c = append(c, OverrideChange{
+			ChangeType: types.StringValue(v.ChangeType),
+			Path:       types.StringPointerValue(v.Path),
+		})
+	}
+
+	return c
+}
+
+// DiffOverrideChanges returns the changes that are only in next, and the changes that are only in prev.
+func DiffOverrideChanges(prev, next []OverrideChange) (added []OverrideChange, removed []OverrideChange) {
+	contains := func(changes []OverrideChange, c OverrideChange) bool {
+		for _, v := range changes {
+			if v.ChangeType.Equal(c.ChangeType) && v.Path.Equal(c.Path) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, v := range next {
+		if !contains(prev, v) {
+			added = append(added, v)
+		}
+	}
+
+	for _, v := range prev {
+		if !contains(next, v) {
+			removed = append(removed, v)
+		}
+	}
+
+	return added, removed
+}
+
+func (r *OperationOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *OperationOverrideModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rc, err := r.client.CreateOperationOverrides(ctx, &connect.Request[platformv1.CreateOperationOverridesRequest]{
+		Msg: &platformv1.CreateOperationOverridesRequest{
+			GraphName:     plan.GraphName.ValueString(),
+			Namespace:     plan.Namespace.ValueString(),
+			OperationHash: plan.OperationHash.ValueString(),
+			OperationName: plan.OperationName.ValueString(),
+			Changes:       MapOverrideChangesToNative(plan.Changes),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating operation override", err.Error())
+		return
+	}
+
+	if rc.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error creating operation override", rc.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	plan.Id = plan.OperationHash
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OperationOverrideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *OperationOverrideModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	override, err := findOperationOverride(ctx, r.client, data.GraphName.ValueString(), data.Namespace.ValueString(), data.OperationHash.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading operation override", err.Error())
+		return
+	}
+
+	// The overrides have been removed outside of terraform.
+	if override == nil || override.ChangesOverrideCount == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	rg, err := r.client.GetOperationOverrides(ctx, &connect.Request[platformv1.GetOperationOverridesRequest]{
+		Msg: &platformv1.GetOperationOverridesRequest{
+			GraphName:     data.GraphName.ValueString(),
+			Namespace:     data.Namespace.ValueString(),
+			OperationHash: data.OperationHash.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading operation override", err.Error())
+		return
+	}
+
+	if rg.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error reading operation override", rg.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	data.OperationName = types.StringValue(override.Name)
+	data.Changes = MapOverrideChangesFromNative(rg.Msg.Changes)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OperationOverrideResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan OperationOverrideModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state OperationOverrideModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	added, removed := DiffOverrideChanges(state.Changes, plan.Changes)
+
+	if len(added) > 0 {
+		rc, err := r.client.CreateOperationOverrides(ctx, &connect.Request[platformv1.CreateOperationOverridesRequest]{
+			Msg: &platformv1.CreateOperationOverridesRequest{
+				GraphName:     plan.GraphName.ValueString(),
+				Namespace:     plan.Namespace.ValueString(),
+				OperationHash: plan.OperationHash.ValueString(),
+				OperationName: plan.OperationName.ValueString(),
+				Changes:       MapOverrideChangesToNative(added),
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating operation override", err.Error())
+			return
+		}
+
+		if rc.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+			resp.Diagnostics.AddError("Error updating operation override", rc.Msg.GetResponse().GetDetails())
+			return
+		}
+	}
+
+	if len(removed) > 0 {
+		rr, err := r.client.RemoveOperationOverrides(ctx, &connect.Request[platformv1.RemoveOperationOverridesRequest]{
+			Msg: &platformv1.RemoveOperationOverridesRequest{
+				GraphName:     plan.GraphName.ValueString(),
+				Namespace:     plan.Namespace.ValueString(),
+				OperationHash: plan.OperationHash.ValueString(),
+				Changes:       MapOverrideChangesToNative(removed),
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating operation override", err.Error())
+			return
+		}
+
+		if rr.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+			resp.Diagnostics.AddError("Error updating operation override", rr.Msg.GetResponse().GetDetails())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OperationOverrideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *OperationOverrideModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rr, err := r.client.RemoveOperationOverrides(ctx, &connect.Request[platformv1.RemoveOperationOverridesRequest]{
+		Msg: &platformv1.RemoveOperationOverridesRequest{
+			GraphName:     data.GraphName.ValueString(),
+			Namespace:     data.Namespace.ValueString(),
+			OperationHash: data.OperationHash.ValueString(),
+			Changes:       MapOverrideChangesToNative(data.Changes),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting operation override", err.Error())
+		return
+	}
+
+	if rr.Msg.GetResponse().Code != common.EnumStatusCode_OK && rr.Msg.GetResponse().Code != common.EnumStatusCode_ERR_NOT_FOUND {
+		resp.Diagnostics.AddError("Error deleting operation override", rr.Msg.GetResponse().GetDetails())
+		return
+	}
+}
+
+// findOperationOverride returns the overrides of the operation with the given hash, or nil if the operation has no overrides.
+func findOperationOverride(ctx context.Context, client platformv1connect.PlatformServiceClient, graphName, namespace, hash string) (*platformv1.GetAllOverridesResponse_Override, error) {
+	ra, err := client.GetAllOverrides(ctx, &connect.Request[platformv1.GetAllOverridesRequest]{
+		Msg: &platformv1.GetAllOverridesRequest{
+			GraphName: graphName,
+			Namespace: namespace,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if ra.Msg.GetResponse().Code == common.EnumStatusCode_ERR_NOT_FOUND {
+		return nil, nil
+	}
+
+	if ra.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		return nil, fmt.Errorf("error fetching override list: %s", ra.Msg.GetResponse().GetDetails())
+	}
+
+	for _, o := range ra.Msg.Overrides {
+		if o.Hash == hash {
+			return o, nil
+		}
+	}
+
+	return nil, nil
+}
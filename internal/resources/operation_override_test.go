@@ -0,0 +1,103 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapOverrideChangesToNative(t *testing.T) {
+	path := "Query.employees"
+
+	tests := []struct {
+		name     string
+		changes  []OverrideChange
+		expected []*platformv1.OverrideChange
+	}{
+		{
+			name: "ValidInput",
+			changes: []OverrideChange{
+				{ChangeType: types.StringValue("FIELD_REMOVED"), Path: types.StringValue(path)},
+				{ChangeType: types.StringValue("TYPE_REMOVED"), Path: types.StringNull()},
+			},
+			expected: []*platformv1.OverrideChange{
+				{ChangeType: "FIELD_REMOVED", Path: &path},
+				{ChangeType: "TYPE_REMOVED"},
+			},
+		},
+		{
+			name:    "EmptyInput",
+			changes: []OverrideChange{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := MapOverrideChangesToNative(tt.changes)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestMapOverrideChangesFromNative(t *testing.T) {
+	path := "Query.employees"
+
+	result := MapOverrideChangesFromNative([]*platformv1.OverrideChange{
+		{ChangeType: "FIELD_REMOVED", Path: &path},
+		{ChangeType: "TYPE_REMOVED"},
+	})
+
+	assert.Equal(t, []OverrideChange{
+		{ChangeType: types.StringValue("FIELD_REMOVED"), Path: types.StringValue(path)},
+		{ChangeType: types.StringValue("TYPE_REMOVED"), Path: types.StringNull()},
+	}, result)
+}
+
+func TestDiffOverrideChanges(t *testing.T) {
+	fieldRemoved := OverrideChange{ChangeType: types.StringValue("FIELD_REMOVED"), Path: types.StringValue("Query.employees")}
+	otherFieldRemoved := OverrideChange{ChangeType: types.StringValue("FIELD_REMOVED"), Path: types.StringValue("Query.teams")}
+	typeRemoved := OverrideChange{ChangeType: types.StringValue("TYPE_REMOVED"), Path: types.StringNull()}
+
+	tests := []struct {
+		name            string
+		prev            []OverrideChange
+		next            []OverrideChange
+		expectedAdded   []OverrideChange
+		expectedRemoved []OverrideChange
+	}{
+		{
+			name:          "Added",
+			prev:          []OverrideChange{fieldRemoved},
+			next:          []OverrideChange{fieldRemoved, typeRemoved},
+			expectedAdded: []OverrideChange{typeRemoved},
+		},
+		{
+			name:            "Removed",
+			prev:            []OverrideChange{fieldRemoved, typeRemoved},
+			next:            []OverrideChange{typeRemoved},
+			expectedRemoved: []OverrideChange{fieldRemoved},
+		},
+		{
+			name:            "DifferentPath",
+			prev:            []OverrideChange{fieldRemoved},
+			next:            []OverrideChange{otherFieldRemoved},
+			expectedAdded:   []OverrideChange{otherFieldRemoved},
+			expectedRemoved: []OverrideChange{fieldRemoved},
+		},
+		{
+			name: "Unchanged",
+			prev: []OverrideChange{fieldRemoved, typeRemoved},
+			next: []OverrideChange{typeRemoved, fieldRemoved},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed := DiffOverrideChanges(tt.prev, tt.next)
+			assert.Equal(t, tt.expectedAdded, added)
+			assert.Equal(t, tt.expectedRemoved, removed)
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package resources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OrganizationResource{}
+
+// organizationId is the id of the resource. The API key belongs to a single organization and the API does not return
+// a stable identifier of it, so a fixed id is used.
+const organizationId = "organization"
+
+func NewOrganizationResource() resource.Resource {
+	return &OrganizationResource{}
+}
+
+// OrganizationResource defines the resource implementation.
+type OrganizationResource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// OrganizationModel describes the resource data model.
+type OrganizationModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Slug        types.String `tfsdk:"slug"`
+	AllowDelete types.Bool   `tfsdk:"allow_delete"`
+}
+
+func (r *OrganizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+func (r *OrganizationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Details of the organization the API key belongs to. The organization is adopted when the resource is created, and is only deleted on destroy when `allow_delete` is set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, always `organization`, as the API key belongs to a single organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization.",
+				Required:            true,
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization, which is used in the urls of the studio. The slug is not returned by the API, so it cannot be read back and changes made outside of terraform are not detected.",
+				Required:            true,
+			},
+			"allow_delete": schema.BoolAttribute{
+				MarkdownDescription: "Delete the organization, including all of its graphs, when the resource is destroyed. When not set, destroying the resource only removes it from the state. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *OrganizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *OrganizationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Organizations cannot be created with an API key, so we adopt the organization the API key belongs to.
+	if _, err := r.organizationName(ctx); err != nil {
+		resp.Diagnostics.AddError("Error reading organization", err.Error())
+		return
+	}
+
+	r.updateDetails(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(organizationId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *OrganizationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name, err := r.organizationName(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization", err.Error())
+		return
+	}
+
+	data.Name = types.StringValue(name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan OrganizationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state OrganizationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Name.Equal(state.Name) || !plan.Slug.Equal(state.Slug) {
+		r.updateDetails(ctx, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *OrganizationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without the guard the organization is only removed from the state.
+	if !data.AllowDelete.ValueBool() {
+		return
+	}
+
+	rd, err := r.client.DeleteOrganization(ctx, &connect.Request[platformv1.DeleteOrganizationRequest]{
+		Msg: &platformv1.DeleteOrganizationRequest{},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting organization", err.Error())
+		return
+	}
+
+	if rd.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error deleting organization", rd.Msg.GetResponse().GetDetails())
+		return
+	}
+}
+
+// organizationName returns the name of the organization the API key belongs to.
+func (r *OrganizationResource) organizationName(ctx context.Context) (string, error) {
+	rw, err := r.client.WhoAmI(ctx, &connect.Request[platformv1.WhoAmIRequest]{
+		Msg: &platformv1.WhoAmIRequest{},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if rw.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		return "", fmt.Errorf("error fetching organization: %s", rw.Msg.GetResponse().GetDetails())
+	}
+
+	return rw.Msg.OrganizationName, nil
+}
+
+// updateDetails updates the name and slug of the organization.
+func (r *OrganizationResource) updateDetails(ctx context.Context, plan *OrganizationModel, diags *diag.Diagnostics) {
+	ru, err := r.client.UpdateOrganizationDetails(ctx, &connect.Request[platformv1.UpdateOrganizationDetailsRequest]{
+		Msg: &platformv1.UpdateOrganizationDetailsRequest{
+			OrganizationName: plan.Name.ValueString(),
+			OrganizationSlug: plan.Slug.ValueString(),
+		},
+	})
+	if err != nil {
+		diags.AddError("Error updating organization", err.Error())
+		return
+	}
+
+	if ru.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		diags.AddError("Error updating organization", ru.Msg.GetResponse().GetDetails())
+		return
+	}
+}
@@ -0,0 +1,206 @@
+package resources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OrganizationFeatureResource{}
+
+func NewOrganizationFeatureResource() resource.Resource {
+	return &OrganizationFeatureResource{}
+}
+
+// OrganizationFeatureResource defines the resource implementation.
+type OrganizationFeatureResource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// OrganizationFeatureModel describes the resource data model.
+type OrganizationFeatureModel struct {
+	Id              types.String `tfsdk:"id"`
+	Feature         types.String `tfsdk:"feature"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	PreviousEnabled types.Bool   `tfsdk:"previous_enabled"`
+}
+
+func (r *OrganizationFeatureResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_feature"
+}
+
+func (r *OrganizationFeatureResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Feature setting of the organization.\n\n" +
+			"The controlplane has no endpoint to read the current feature settings or the billing plan of the organization, which limits this resource:\n\n" +
+			"- Changes made outside of terraform are not detected, the resource always reports the values of the last apply.\n" +
+			"- A feature that is not available on the billing plan of the organization is only rejected when applying, not when planning.\n" +
+			"- The setting before the resource was created is not captured, see `previous_enabled`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, equal to the feature.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"feature": schema.StringAttribute{
+				MarkdownDescription: "The feature to manage. The supported features are rbac, ai and scim. Some features are only available on specific billing plans.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("rbac", "ai", "scim"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the feature is enabled.",
+				Required:            true,
+			},
+			"previous_enabled": schema.BoolAttribute{
+				MarkdownDescription: "The value to set when the resource is destroyed. The provider does not capture the setting before the resource was created, so this has to be set to the value to restore. When not set, the feature setting is left as is.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *OrganizationFeatureResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func MapFeatureToNative(feature types.String) (platformv1.Feature, error) {
+	f, ok := platformv1.Feature_value[feature.ValueString()]
+	if !ok {
+		return 0, fmt.Errorf("unsupported feature: %s", feature.ValueString())
+	}
+
+	return platformv1.Feature(f), nil
+}
+
+func (r *OrganizationFeatureResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *OrganizationFeatureModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateFeature(ctx, plan.Feature, plan.Enabled.ValueBool(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = plan.Feature
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationFeatureResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// There is no endpoint to fetch the feature settings of the organization, so we keep the values from the state and
+	// changes made outside of terraform are not detected.
+	var data *OrganizationFeatureModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationFeatureResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan OrganizationFeatureModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state OrganizationFeatureModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Enabled.Equal(state.Enabled) {
+		r.updateFeature(ctx, plan.Feature, plan.Enabled.ValueBool(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationFeatureResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *OrganizationFeatureModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.PreviousEnabled.IsNull() || data.PreviousEnabled.Equal(data.Enabled) {
+		return
+	}
+
+	r.updateFeature(ctx, data.Feature, data.PreviousEnabled.ValueBool(), &resp.Diagnostics)
+}
+
+// updateFeature enables or disables the feature for the organization.
+func (r *OrganizationFeatureResource) updateFeature(ctx context.Context, feature types.String, enable bool, diags *diag.Diagnostics) {
+	f, err := MapFeatureToNative(feature)
+	if err != nil {
+		diags.AddError("Error updating organization feature", err.Error())
+		return
+	}
+
+	ru, err := r.client.UpdateFeatureSettings(ctx, &connect.Request[platformv1.UpdateFeatureSettingsRequest]{
+		Msg: &platformv1.UpdateFeatureSettingsRequest{
+			Enable:    enable,
+			FeatureId: f,
+		},
+	})
+	if err != nil {
+		diags.AddError("Error updating organization feature", err.Error())
+		return
+	}
+
+	switch ru.Msg.GetResponse().Code {
+	case common.EnumStatusCode_OK:
+		return
+	case common.EnumStatusCode_ERR_LIMIT_REACHED, common.EnumStatusCode_ERR_FREE_TRIAL_EXPIRED:
+		diags.AddError(
+			"Error updating organization feature",
+			fmt.Sprintf("The feature %s is not available on the current billing plan of the organization: %s", feature.ValueString(), ru.Msg.GetResponse().GetDetails()),
+		)
+	default:
+		diags.AddError("Error updating organization feature", ru.Msg.GetResponse().GetDetails())
+	}
+}
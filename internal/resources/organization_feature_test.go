@@ -0,0 +1,36 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapFeatureToNative(t *testing.T) {
+	tests := []struct {
+		name        string
+		feature     types.String
+		expected    platformv1.Feature
+		expectError bool
+	}{
+		{name: "RBAC", feature: types.StringValue("rbac"), expected: platformv1.Feature_rbac},
+		{name: "AI", feature: types.StringValue("ai"), expected: platformv1.Feature_ai},
+		{name: "SCIM", feature: types.StringValue("scim"), expected: platformv1.Feature_scim},
+		{name: "Unsupported", feature: types.StringValue("sso"), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := MapFeatureToNative(tt.feature)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
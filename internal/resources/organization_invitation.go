@@ -0,0 +1,228 @@
+package resources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OrganizationInvitationResource{}
+
+func NewOrganizationInvitationResource() resource.Resource {
+	return &OrganizationInvitationResource{}
+}
+
+// OrganizationInvitationResource defines the resource implementation.
+type OrganizationInvitationResource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// OrganizationInvitationModel describes the resource data model.
+type OrganizationInvitationModel struct {
+	Id     types.String `tfsdk:"id"`
+	Email  types.String `tfsdk:"email"`
+	Status types.String `tfsdk:"status"`
+}
+
+const (
+	invitationStatusPending  = "pending"
+	invitationStatusAccepted = "accepted"
+)
+
+func (r *OrganizationInvitationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_invitation"
+}
+
+func (r *OrganizationInvitationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Invitation of a user to the organization. Destroying the resource removes a pending invitation. Once the invitation has been accepted, the membership is managed with `wundergraph_organization_member_role`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, equal to the email address.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the user to invite.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the invitation, either pending or accepted.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationInvitationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationInvitationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *OrganizationInvitationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rc, err := r.client.InviteUser(ctx, &connect.Request[platformv1.InviteUserRequest]{
+		Msg: &platformv1.InviteUserRequest{
+			Email: plan.Email.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating organization invitation", err.Error())
+		return
+	}
+
+	if rc.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error creating organization invitation", rc.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	plan.Id = plan.Email
+	plan.Status = types.StringValue(invitationStatusPending)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationInvitationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *OrganizationInvitationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := r.status(ctx, data.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization invitation", err.Error())
+		return
+	}
+
+	// The invitation has been removed or declined, or the member has left the organization.
+	if status == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Status = types.StringValue(status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInvitationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require a replacement, so there is nothing to update in place.
+	var plan OrganizationInvitationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationInvitationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *OrganizationInvitationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An accepted invitation no longer exists, the membership itself is managed by the member role resource.
+	if data.Status.ValueString() == invitationStatusAccepted {
+		return
+	}
+
+	rd, err := r.client.RemoveInvitation(ctx, &connect.Request[platformv1.RemoveInvitationRequest]{
+		Msg: &platformv1.RemoveInvitationRequest{
+			Email: data.Email.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting organization invitation", err.Error())
+		return
+	}
+
+	if rd.Msg.GetResponse().Code != common.EnumStatusCode_OK && rd.Msg.GetResponse().Code != common.EnumStatusCode_ERR_NOT_FOUND {
+		resp.Diagnostics.AddError("Error deleting organization invitation", rd.Msg.GetResponse().GetDetails())
+		return
+	}
+}
+
+// status returns the status of the invitation of the given email address, or an empty string if there is no invitation.
+// GetInvitations only lists the invitations of the calling user, so the pending members of the organization are used instead.
+func (r *OrganizationInvitationResource) status(ctx context.Context, email string) (string, error) {
+	for offset := int32(0); ; offset += organizationMembersPageSize {
+		rp, err := r.client.GetPendingOrganizationMembers(ctx, &connect.Request[platformv1.GetPendingOrganizationMembersRequest]{
+			Msg: &platformv1.GetPendingOrganizationMembersRequest{
+				Pagination: &platformv1.Pagination{
+					Limit:  organizationMembersPageSize,
+					Offset: offset,
+				},
+				Search: &email,
+			},
+		})
+		if err != nil {
+			return "", err
+		}
+
+		if rp.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+			return "", fmt.Errorf("error fetching pending organization member list: %s", rp.Msg.GetResponse().GetDetails())
+		}
+
+		for _, m := range rp.Msg.PendingInvitations {
+			if strings.EqualFold(m.Email, email) {
+				return invitationStatusPending, nil
+			}
+		}
+
+		if len(rp.Msg.PendingInvitations) < organizationMembersPageSize || offset+organizationMembersPageSize >= rp.Msg.TotalCount {
+			break
+		}
+	}
+
+	member, err := findOrganizationMember(ctx, r.client, email)
+	if err != nil {
+		return "", err
+	}
+
+	if member == nil {
+		return "", nil
+	}
+
+	return invitationStatusAccepted, nil
+}
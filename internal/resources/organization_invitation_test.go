@@ -0,0 +1,82 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func invitationClient(pending []*platformv1.PendingOrgInvitation, members []*platformv1.OrgMember) *fakeClient {
+	var offsets []int32
+	client := membersClient(members, &offsets)
+	client.getPendingOrganizationMembers = func(req *platformv1.GetPendingOrganizationMembersRequest) *platformv1.GetPendingOrganizationMembersResponse {
+		return &platformv1.GetPendingOrganizationMembersResponse{
+			Response:           &platformv1.Response{Code: common.EnumStatusCode_OK},
+			PendingInvitations: pageOf(pending, req.Pagination),
+			TotalCount:         int32(len(pending)),
+		}
+	}
+
+	return client
+}
+
+func TestOrganizationInvitationStatus(t *testing.T) {
+	pending := make([]*platformv1.PendingOrgInvitation, 0, 150)
+	for _, m := range testMembers(150) {
+		pending = append(pending, &platformv1.PendingOrgInvitation{UserID: m.UserID, Email: "pending-" + m.Email})
+	}
+
+	tests := []struct {
+		name     string
+		email    string
+		expected string
+	}{
+		{
+			name:     "PendingOnSecondPage",
+			email:    "pending-user-120@example.com",
+			expected: invitationStatusPending,
+		},
+		{
+			name:     "Accepted",
+			email:    "user-2@example.com",
+			expected: invitationStatusAccepted,
+		},
+		{
+			name:     "Removed",
+			email:    "unknown@example.com",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &OrganizationInvitationResource{client: invitationClient(pending, testMembers(3))}
+
+			status, err := r.status(context.Background(), tt.email)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, status)
+		})
+	}
+}
+
+func TestOrganizationInvitationReadRemoved(t *testing.T) {
+	r := &OrganizationInvitationResource{client: invitationClient(nil, testMembers(3))}
+
+	state := newTestState(t, r, &OrganizationInvitationModel{
+		Id:     types.StringValue("gone@example.com"),
+		Email:  types.StringValue("gone@example.com"),
+		Status: types.StringValue(invitationStatusAccepted),
+	})
+	resp := resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+
+	require.False(t, resp.Diagnostics.HasError())
+	assert.True(t, resp.State.Raw.IsNull())
+}
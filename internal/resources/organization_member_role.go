@@ -0,0 +1,264 @@
+package resources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OrganizationMemberRoleResource{}
+
+func NewOrganizationMemberRoleResource() resource.Resource {
+	return &OrganizationMemberRoleResource{}
+}
+
+// OrganizationMemberRoleResource defines the resource implementation.
+type OrganizationMemberRoleResource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// OrganizationMemberRoleModel describes the resource data model.
+type OrganizationMemberRoleModel struct {
+	Id     types.String `tfsdk:"id"`
+	Email  types.String `tfsdk:"email"`
+	Role   types.String `tfsdk:"role"`
+	UserId types.String `tfsdk:"user_id"`
+}
+
+// organizationMembersPageSize is the number of members fetched per request when searching the organization members.
+const organizationMembersPageSize = 100
+
+func (r *OrganizationMemberRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_member_role"
+}
+
+func (r *OrganizationMemberRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Role of a member of the organization. The user has to be a member already, for example by accepting a `wundergraph_organization_invitation`. Destroying the resource removes the member from the organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the member.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The role of the member. The supported roles are admin, developer and viewer.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("admin", "developer", "viewer"),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the user.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationMemberRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationMemberRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *OrganizationMemberRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := findOrganizationMember(ctx, r.client, plan.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization member", err.Error())
+		return
+	}
+
+	if member == nil {
+		resp.Diagnostics.AddError("Error reading organization member", fmt.Sprintf("%s is not a member of the organization, the user has to accept an invitation first", plan.Email.ValueString()))
+		return
+	}
+
+	if !containsRole(member.Roles, plan.Role.ValueString()) {
+		ru, err := r.client.UpdateOrgMemberRole(ctx, &connect.Request[platformv1.UpdateOrgMemberRoleRequest]{
+			Msg: &platformv1.UpdateOrgMemberRoleRequest{
+				UserID:          member.UserID,
+				OrgMemberUserID: member.UserID,
+				Role:            plan.Role.ValueString(),
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating organization member role", err.Error())
+			return
+		}
+
+		if ru.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+			resp.Diagnostics.AddError("Error updating organization member role", ru.Msg.GetResponse().GetDetails())
+			return
+		}
+	}
+
+	plan.Id = types.StringValue(member.OrgMemberID)
+	plan.UserId = types.StringValue(member.UserID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationMemberRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *OrganizationMemberRoleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := findOrganizationMember(ctx, r.client, data.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization member", err.Error())
+		return
+	}
+
+	// The member has left or has been removed from the organization outside of terraform.
+	if member == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.StringValue(member.OrgMemberID)
+	data.UserId = types.StringValue(member.UserID)
+	if !containsRole(member.Roles, data.Role.ValueString()) && len(member.Roles) > 0 {
+		data.Role = types.StringValue(member.Roles[0])
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMemberRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan OrganizationMemberRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ru, err := r.client.UpdateOrgMemberRole(ctx, &connect.Request[platformv1.UpdateOrgMemberRoleRequest]{
+		Msg: &platformv1.UpdateOrgMemberRoleRequest{
+			UserID:          plan.UserId.ValueString(),
+			OrgMemberUserID: plan.UserId.ValueString(),
+			Role:            plan.Role.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating organization member role", err.Error())
+		return
+	}
+
+	if ru.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error updating organization member role", ru.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationMemberRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *OrganizationMemberRoleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rd, err := r.client.RemoveOrganizationMember(ctx, &connect.Request[platformv1.RemoveOrganizationMemberRequest]{
+		Msg: &platformv1.RemoveOrganizationMemberRequest{
+			Email: data.Email.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting organization member", err.Error())
+		return
+	}
+
+	if rd.Msg.GetResponse().Code != common.EnumStatusCode_OK && rd.Msg.GetResponse().Code != common.EnumStatusCode_ERR_NOT_FOUND {
+		resp.Diagnostics.AddError("Error deleting organization member", rd.Msg.GetResponse().GetDetails())
+		return
+	}
+}
+
+func containsRole(roles []string, role string) bool {
+	for _, v := range roles {
+		if v == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findOrganizationMember returns the member of the organization with the given email address, or nil if there is none.
+func findOrganizationMember(ctx context.Context, client platformv1connect.PlatformServiceClient, email string) (*platformv1.OrgMember, error) {
+	for offset := int32(0); ; offset += organizationMembersPageSize {
+		rg, err := client.GetOrganizationMembers(ctx, &connect.Request[platformv1.GetOrganizationMembersRequest]{
+			Msg: &platformv1.GetOrganizationMembersRequest{
+				Pagination: &platformv1.Pagination{
+					Limit:  organizationMembersPageSize,
+					Offset: offset,
+				},
+				Search: &email,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if rg.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+			return nil, fmt.Errorf("error fetching organization member list: %s", rg.Msg.GetResponse().GetDetails())
+		}
+
+		for _, m := range rg.Msg.Members {
+			if strings.EqualFold(m.Email, email) {
+				return m, nil
+			}
+		}
+
+		if len(rg.Msg.Members) < organizationMembersPageSize || offset+organizationMembersPageSize >= rg.Msg.TotalCount {
+			return nil, nil
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testMembers returns the given number of organization members, named user-<n>@example.com.
+func testMembers(count int) []*platformv1.OrgMember {
+	members := make([]*platformv1.OrgMember, 0, count)
+	for i := 0; i < count; i++ {
+		members = append(members, &platformv1.OrgMember{
+			UserID:      fmt.Sprintf("user-%d", i),
+			Email:       fmt.Sprintf("user-%d@example.com", i),
+			OrgMemberID: fmt.Sprintf("member-%d", i),
+			Roles:       []string{"developer"},
+		})
+	}
+
+	return members
+}
+
+// membersClient serves the members in pages, ignoring the search, and records the requested offsets.
+func membersClient(members []*platformv1.OrgMember, offsets *[]int32) *fakeClient {
+	return &fakeClient{
+		getOrganizationMembers: func(req *platformv1.GetOrganizationMembersRequest) *platformv1.GetOrganizationMembersResponse {
+			*offsets = append(*offsets, req.Pagination.Offset)
+			return &platformv1.GetOrganizationMembersResponse{
+				Response:   &platformv1.Response{Code: common.EnumStatusCode_OK},
+				Members:    pageOf(members, req.Pagination),
+				TotalCount: int32(len(members)),
+			}
+		},
+	}
+}
+
+func TestFindOrganizationMember(t *testing.T) {
+	tests := []struct {
+		name            string
+		members         int
+		email           string
+		expectedId      string
+		expectedOffsets []int32
+	}{
+		{
+			name:            "FirstPage",
+			members:         250,
+			email:           "user-5@example.com",
+			expectedId:      "member-5",
+			expectedOffsets: []int32{0},
+		},
+		{
+			name:            "LastPage",
+			members:         250,
+			email:           "user-249@example.com",
+			expectedId:      "member-249",
+			expectedOffsets: []int32{0, 100, 200},
+		},
+		{
+			name:            "CaseInsensitive",
+			members:         10,
+			email:           "USER-3@example.com",
+			expectedId:      "member-3",
+			expectedOffsets: []int32{0},
+		},
+		{
+			name:            "NotFound",
+			members:         250,
+			email:           "unknown@example.com",
+			expectedOffsets: []int32{0, 100, 200},
+		},
+		{
+			name:            "NotFoundFullPages",
+			members:         200,
+			email:           "unknown@example.com",
+			expectedOffsets: []int32{0, 100},
+		},
+		{
+			name:            "NoMembers",
+			members:         0,
+			email:           "unknown@example.com",
+			expectedOffsets: []int32{0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var offsets []int32
+			client := membersClient(testMembers(tt.members), &offsets)
+
+			member, err := findOrganizationMember(context.Background(), client, tt.email)
+
+			assert.NoError(t, err)
+			if tt.expectedId == "" {
+				assert.Nil(t, member)
+			} else {
+				require.NotNil(t, member)
+				assert.Equal(t, tt.expectedId, member.OrgMemberID)
+			}
+			assert.Equal(t, tt.expectedOffsets, offsets)
+		})
+	}
+}
+
+func TestFindOrganizationMemberError(t *testing.T) {
+	details := "not allowed"
+	client := &fakeClient{
+		getOrganizationMembers: func(*platformv1.GetOrganizationMembersRequest) *platformv1.GetOrganizationMembersResponse {
+			return &platformv1.GetOrganizationMembersResponse{
+				Response: &platformv1.Response{Code: common.EnumStatusCode_ERROR_NOT_AUTHORIZED, Details: &details},
+			}
+		},
+	}
+
+	_, err := findOrganizationMember(context.Background(), client, "user-1@example.com")
+
+	assert.ErrorContains(t, err, details)
+}
+
+func TestContainsRole(t *testing.T) {
+	assert.True(t, containsRole([]string{"admin", "developer"}, "developer"))
+	assert.False(t, containsRole([]string{"viewer"}, "developer"))
+	assert.False(t, containsRole(nil, "developer"))
+}
+
+func TestOrganizationMemberRoleRead(t *testing.T) {
+	tests := []struct {
+		name         string
+		email        string
+		role         string
+		expectRemove bool
+		expectedRole string
+	}{
+		{
+			name:         "Member",
+			email:        "user-1@example.com",
+			role:         "developer",
+			expectedRole: "developer",
+		},
+		{
+			name:         "RoleChanged",
+			email:        "user-1@example.com",
+			role:         "admin",
+			expectedRole: "developer",
+		},
+		{
+			name:         "MemberLeft",
+			email:        "gone@example.com",
+			role:         "developer",
+			expectRemove: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var offsets []int32
+			r := &OrganizationMemberRoleResource{client: membersClient(testMembers(3), &offsets)}
+
+			state := newTestState(t, r, &OrganizationMemberRoleModel{
+				Id:     types.StringValue("member-1"),
+				Email:  types.StringValue(tt.email),
+				Role:   types.StringValue(tt.role),
+				UserId: types.StringValue("user-1"),
+			})
+			resp := resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+			require.False(t, resp.Diagnostics.HasError())
+
+			if tt.expectRemove {
+				assert.True(t, resp.State.Raw.IsNull())
+				return
+			}
+
+			var data OrganizationMemberRoleModel
+			require.False(t, resp.State.Get(context.Background(), &data).HasError())
+			assert.Equal(t, types.StringValue(tt.expectedRole), data.Role)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationDelete(t *testing.T) {
+	tests := []struct {
+		name           string
+		allowDelete    bool
+		expectedDelete bool
+	}{
+		{
+			name:           "AllowDelete",
+			allowDelete:    true,
+			expectedDelete: true,
+		},
+		{
+			name:           "OnlyRemovedFromState",
+			allowDelete:    false,
+			expectedDelete: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deleted bool
+			r := &OrganizationResource{client: &fakeClient{
+				deleteOrganization: func(*platformv1.DeleteOrganizationRequest) *platformv1.DeleteOrganizationResponse {
+					deleted = true
+					return &platformv1.DeleteOrganizationResponse{
+						Response: &platformv1.Response{Code: common.EnumStatusCode_OK},
+					}
+				},
+			}}
+
+			req := resource.DeleteRequest{State: newTestState(t, &OrganizationResource{}, &OrganizationModel{
+				Id:          types.StringValue("organization"),
+				Name:        types.StringValue("acme"),
+				Slug:        types.StringValue("acme"),
+				AllowDelete: types.BoolValue(tt.allowDelete),
+			})}
+			var resp resource.DeleteResponse
+			r.Delete(context.Background(), req, &resp)
+
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.expectedDelete, deleted)
+		})
+	}
+}
+
+func TestOrganizationRead(t *testing.T) {
+	r := &OrganizationResource{client: &fakeClient{
+		whoAmI: func(*platformv1.WhoAmIRequest) *platformv1.WhoAmIResponse {
+			return &platformv1.WhoAmIResponse{
+				Response:         &platformv1.Response{Code: common.EnumStatusCode_OK},
+				OrganizationName: "acme-renamed",
+			}
+		},
+	}}
+
+	state := newTestState(t, &OrganizationResource{}, &OrganizationModel{
+		Id:          types.StringValue("organization"),
+		Name:        types.StringValue("acme"),
+		Slug:        types.StringValue("acme"),
+		AllowDelete: types.BoolValue(false),
+	})
+	resp := resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+	require.False(t, resp.Diagnostics.HasError())
+
+	var data OrganizationModel
+	require.False(t, resp.State.Get(context.Background(), &data).HasError())
+	assert.Equal(t, types.StringValue("organization"), data.Id)
+	assert.Equal(t, types.StringValue("acme-renamed"), data.Name)
+}
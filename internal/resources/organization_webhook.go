@@ -0,0 +1,366 @@
+package resources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/notifications"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OrganizationWebhookResource{}
+var _ resource.ResourceWithImportState = &OrganizationWebhookResource{}
+
+func NewOrganizationWebhookResource() resource.Resource {
+	return &OrganizationWebhookResource{}
+}
+
+// OrganizationWebhookResource defines the resource implementation.
+type OrganizationWebhookResource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// OrganizationWebhookModel describes the resource data model.
+type OrganizationWebhookModel struct {
+	Id                          types.String `tfsdk:"id"`
+	Endpoint                    types.String `tfsdk:"endpoint"`
+	Key                         types.String `tfsdk:"key"`
+	FederatedGraphSchemaUpdated *EventGraphs `tfsdk:"federated_graph_schema_updated"`
+	MonographSchemaUpdated      *EventGraphs `tfsdk:"monograph_schema_updated"`
+}
+
+// EventGraphs describes the graphs an organization event is sent for.
+type EventGraphs struct {
+	GraphIds types.Set `tfsdk:"graph_ids"`
+}
+
+func (r *OrganizationWebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_webhook"
+}
+
+// eventGraphsAttribute returns the schema of an organization event, which is shared by the webhook and integration resources.
+func eventGraphsAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"graph_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The ids of the graphs to send the event for.",
+				Required:            true,
+			},
+		},
+		MarkdownDescription: description,
+		Optional:            true,
+	}
+}
+
+func (r *OrganizationWebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Organization webhook, which notifies an endpoint about events in the organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "The url the events are sent to.",
+				Required:            true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The key used to sign the requests to the endpoint.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"federated_graph_schema_updated": eventGraphsAttribute("Send an event when the schema of one of the federated graphs is updated."),
+			"monograph_schema_updated":       eventGraphsAttribute("Send an event when the schema of one of the monographs is updated."),
+		},
+	}
+}
+
+func (r *OrganizationWebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// MapEventsToNative maps the configured organization events to the event names and metadata used by the API. An
+// event is only subscribed to when it is configured.
+func MapEventsToNative(ctx context.Context, federatedGraphSchemaUpdated *EventGraphs, monographSchemaUpdated *EventGraphs) ([]string, []*notifications.EventMeta, diag.Diagnostics) {
+	var events []string
+	var meta []*notifications.EventMeta
+
+	if federatedGraphSchemaUpdated != nil {
+		var graphIds []string
+		diags := federatedGraphSchemaUpdated.GraphIds.ElementsAs(ctx, &graphIds, false)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+
+		events = append(events, notifications.OrganizationEventName_FEDERATED_GRAPH_SCHEMA_UPDATED.String())
+		meta = append(meta, &notifications.EventMeta{
+			EventName: notifications.OrganizationEventName_FEDERATED_GRAPH_SCHEMA_UPDATED,
+			Meta: &notifications.EventMeta_FederatedGraphSchemaUpdated{
+				FederatedGraphSchemaUpdated: &notifications.GraphSchemaUpdatedMeta{GraphIds: graphIds},
+			},
+		})
+	}
+
+	if monographSchemaUpdated != nil {
+		var graphIds []string
+		diags := monographSchemaUpdated.GraphIds.ElementsAs(ctx, &graphIds, false)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+
+		events = append(events, notifications.OrganizationEventName_MONOGRAPH_SCHEMA_UPDATED.String())
+		meta = append(meta, &notifications.EventMeta{
+			EventName: notifications.OrganizationEventName_MONOGRAPH_SCHEMA_UPDATED,
+			Meta: &notifications.EventMeta_MonographSchemaUpdated{
+				MonographSchemaUpdated: &notifications.GraphSchemaUpdatedMeta{GraphIds: graphIds},
+			},
+		})
+	}
+
+	return events, meta, nil
+}
+
+// MapEventsFromNative maps the subscribed event names and their metadata back to the configured organization events.
+// Events which are not subscribed to are returned as nil.
+func MapEventsFromNative(ctx context.Context, events []string, meta []*notifications.EventMeta) (*EventGraphs, *EventGraphs, diag.Diagnostics) {
+	graphIds := make(map[notifications.OrganizationEventName][]string)
+	for _, m := range meta {
+		switch v := m.Meta.(type) {
+		case *notifications.EventMeta_FederatedGraphSchemaUpdated:
+			graphIds[m.EventName] = append(graphIds[m.EventName], v.FederatedGraphSchemaUpdated.GetGraphIds()...)
+		case *notifications.EventMeta_MonographSchemaUpdated:
+			graphIds[m.EventName] = append(graphIds[m.EventName], v.MonographSchemaUpdated.GetGraphIds()...)
+		}
+	}
+
+	var federatedGraphSchemaUpdated, monographSchemaUpdated *EventGraphs
+	for _, e := range events {
+		name, ok := notifications.OrganizationEventName_value[e]
+		if !ok {
+			// Events which the provider does not know about are ignored.
+			continue
+		}
+
+		ids := graphIds[notifications.OrganizationEventName(name)]
+		if ids == nil {
+			ids = []string{}
+		}
+
+		set, diags := types.SetValueFrom(ctx, types.StringType, ids)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+
+		switch notifications.OrganizationEventName(name) {
+		case notifications.OrganizationEventName_FEDERATED_GRAPH_SCHEMA_UPDATED:
+			federatedGraphSchemaUpdated = &EventGraphs{GraphIds: set}
+		case notifications.OrganizationEventName_MONOGRAPH_SCHEMA_UPDATED:
+			monographSchemaUpdated = &EventGraphs{GraphIds: set}
+		}
+	}
+
+	return federatedGraphSchemaUpdated, monographSchemaUpdated, nil
+}
+
+func (r *OrganizationWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *OrganizationWebhookModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	events, meta, diags := MapEventsToNative(ctx, plan.FederatedGraphSchemaUpdated, plan.MonographSchemaUpdated)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rc, err := r.client.CreateOrganizationWebhookConfig(ctx, &connect.Request[platformv1.CreateOrganizationWebhookConfigRequest]{
+		Msg: &platformv1.CreateOrganizationWebhookConfigRequest{
+			Endpoint:   plan.Endpoint.ValueString(),
+			Key:        plan.Key.ValueString(),
+			Events:     events,
+			EventsMeta: meta,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating organization webhook", err.Error())
+		return
+	}
+
+	if rc.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error creating organization webhook", rc.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	plan.Id = types.StringValue(rc.Msg.WebhookConfigId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *OrganizationWebhookModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// We fetch the webhook list to get the requested webhook, as we don't have a direct read endpoint.
+	rl, err := r.client.GetOrganizationWebhookConfigs(ctx, &connect.Request[platformv1.GetOrganizationWebhookConfigsRequest]{
+		Msg: &platformv1.GetOrganizationWebhookConfigsRequest{},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization webhook", err.Error())
+		return
+	}
+
+	if rl.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error fetching organization webhook list", rl.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	var config *platformv1.GetOrganizationWebhookConfigsResponse_Config
+	for _, c := range rl.Msg.Configs {
+		if c.Id == data.Id.ValueString() {
+			config = c
+			continue
+		}
+	}
+
+	// The webhook has been removed outside of terraform, so it has to be created again.
+	if config == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	rm, err := r.client.GetOrganizationWebhookMeta(ctx, &connect.Request[platformv1.GetOrganizationWebhookMetaRequest]{
+		Msg: &platformv1.GetOrganizationWebhookMetaRequest{
+			Id: config.Id,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization webhook", err.Error())
+		return
+	}
+
+	if rm.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error reading organization webhook", rm.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	federatedGraphSchemaUpdated, monographSchemaUpdated, diags := MapEventsFromNative(ctx, config.Events, rm.Msg.EventsMeta)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := &OrganizationWebhookModel{
+		Id:                          types.StringValue(config.Id),
+		Endpoint:                    types.StringValue(config.Endpoint),
+		FederatedGraphSchemaUpdated: federatedGraphSchemaUpdated,
+		MonographSchemaUpdated:      monographSchemaUpdated,
+		// The key is never returned by the API, so we keep the value from the state.
+		Key: data.Key,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &current)...)
+}
+
+func (r *OrganizationWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan OrganizationWebhookModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state OrganizationWebhookModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	events, meta, diags := MapEventsToNative(ctx, plan.FederatedGraphSchemaUpdated, plan.MonographSchemaUpdated)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ru, err := r.client.UpdateOrganizationWebhookConfig(ctx, &connect.Request[platformv1.UpdateOrganizationWebhookConfigRequest]{
+		Msg: &platformv1.UpdateOrganizationWebhookConfigRequest{
+			Id:              state.Id.ValueString(),
+			Endpoint:        plan.Endpoint.ValueString(),
+			Key:             plan.Key.ValueString(),
+			Events:          events,
+			EventsMeta:      meta,
+			ShouldUpdateKey: !plan.Key.Equal(state.Key),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating organization webhook", err.Error())
+		return
+	}
+
+	if ru.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error updating organization webhook", ru.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *OrganizationWebhookModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rd, err := r.client.DeleteOrganizationWebhookConfig(ctx, &connect.Request[platformv1.DeleteOrganizationWebhookConfigRequest]{
+		Msg: &platformv1.DeleteOrganizationWebhookConfigRequest{
+			Id: data.Id.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting organization webhook", err.Error())
+		return
+	}
+
+	if rd.Msg.GetResponse().Code != common.EnumStatusCode_OK && rd.Msg.GetResponse().Code != common.EnumStatusCode_ERR_NOT_FOUND {
+		resp.Diagnostics.AddError("Error deleting organization webhook", rd.Msg.GetResponse().GetDetails())
+		return
+	}
+}
+
+func (r *OrganizationWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,110 @@
+package resources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/notifications"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapEventsToNative(t *testing.T) {
+	tests := []struct {
+		name                        string
+		federatedGraphSchemaUpdated *EventGraphs
+		monographSchemaUpdated      *EventGraphs
+		expectedEvents              []string
+		expectedMeta                []*notifications.EventMeta
+	}{
+		{
+			name:                        "FederatedGraphSchemaUpdated",
+			federatedGraphSchemaUpdated: &EventGraphs{GraphIds: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("graph1")})},
+			expectedEvents:              []string{"FEDERATED_GRAPH_SCHEMA_UPDATED"},
+			expectedMeta: []*notifications.EventMeta{
+				{
+					EventName: notifications.OrganizationEventName_FEDERATED_GRAPH_SCHEMA_UPDATED,
+					Meta: &notifications.EventMeta_FederatedGraphSchemaUpdated{
+						FederatedGraphSchemaUpdated: &notifications.GraphSchemaUpdatedMeta{GraphIds: []string{"graph1"}},
+					},
+				},
+			},
+		},
+		{
+			name:                        "BothEvents",
+			federatedGraphSchemaUpdated: &EventGraphs{GraphIds: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("graph1")})},
+			monographSchemaUpdated:      &EventGraphs{GraphIds: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("graph2")})},
+			expectedEvents:              []string{"FEDERATED_GRAPH_SCHEMA_UPDATED", "MONOGRAPH_SCHEMA_UPDATED"},
+			expectedMeta: []*notifications.EventMeta{
+				{
+					EventName: notifications.OrganizationEventName_FEDERATED_GRAPH_SCHEMA_UPDATED,
+					Meta: &notifications.EventMeta_FederatedGraphSchemaUpdated{
+						FederatedGraphSchemaUpdated: &notifications.GraphSchemaUpdatedMeta{GraphIds: []string{"graph1"}},
+					},
+				},
+				{
+					EventName: notifications.OrganizationEventName_MONOGRAPH_SCHEMA_UPDATED,
+					Meta: &notifications.EventMeta_MonographSchemaUpdated{
+						MonographSchemaUpdated: &notifications.GraphSchemaUpdatedMeta{GraphIds: []string{"graph2"}},
+					},
+				},
+			},
+		},
+		{
+			name: "NoEvents",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, meta, diags := MapEventsToNative(context.Background(), tt.federatedGraphSchemaUpdated, tt.monographSchemaUpdated)
+
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tt.expectedEvents, events)
+			assert.Equal(t, tt.expectedMeta, meta)
+		})
+	}
+}
+
+func TestMapEventsFromNative(t *testing.T) {
+	tests := []struct {
+		name                        string
+		events                      []string
+		meta                        []*notifications.EventMeta
+		federatedGraphSchemaUpdated *EventGraphs
+		monographSchemaUpdated      *EventGraphs
+	}{
+		{
+			name:   "ValidInput",
+			events: []string{"FEDERATED_GRAPH_SCHEMA_UPDATED", "MONOGRAPH_SCHEMA_UPDATED"},
+			meta: []*notifications.EventMeta{
+				{
+					EventName: notifications.OrganizationEventName_FEDERATED_GRAPH_SCHEMA_UPDATED,
+					Meta: &notifications.EventMeta_FederatedGraphSchemaUpdated{
+						FederatedGraphSchemaUpdated: &notifications.GraphSchemaUpdatedMeta{GraphIds: []string{"graph1", "graph2"}},
+					},
+				},
+			},
+			federatedGraphSchemaUpdated: &EventGraphs{GraphIds: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("graph1"), types.StringValue("graph2")})},
+			monographSchemaUpdated:      &EventGraphs{GraphIds: types.SetValueMust(types.StringType, []attr.Value{})},
+		},
+		{
+			name:   "UnknownEvent",
+			events: []string{"UNKNOWN_EVENT"},
+		},
+		{
+			name: "EmptyInput",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			federatedGraphSchemaUpdated, monographSchemaUpdated, diags := MapEventsFromNative(context.Background(), tt.events, tt.meta)
+
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tt.federatedGraphSchemaUpdated, federatedGraphSchemaUpdated)
+			assert.Equal(t, tt.monographSchemaUpdated, monographSchemaUpdated)
+		})
+	}
+}
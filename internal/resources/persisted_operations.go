@@ -0,0 +1,468 @@
+package resources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PersistedOperationsResource{}
+var _ resource.ResourceWithModifyPlan = &PersistedOperationsResource{}
+
+func NewPersistedOperationsResource() resource.Resource {
+	return &PersistedOperationsResource{}
+}
+
+// PersistedOperationsResource defines the resource implementation.
+type PersistedOperationsResource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// PersistedOperationsModel describes the resource data model.
+type PersistedOperationsModel struct {
+	Id                 types.String `tfsdk:"id"`
+	FederatedGraphName types.String `tfsdk:"federated_graph_name"`
+	Namespace          types.String `tfsdk:"namespace"`
+	ClientName         types.String `tfsdk:"client_name"`
+	Operations         types.Map    `tfsdk:"operations"`
+	ManifestFile       types.String `tfsdk:"manifest_file"`
+	OperationHashes    types.Map    `tfsdk:"operation_hashes"`
+}
+
+// apolloManifestFormat is the format identifier of an Apollo persisted query manifest.
+const apolloManifestFormat = "apollo-persisted-query-manifest"
+
+type apolloManifest struct {
+	Format     string `json:"format"`
+	Operations []struct {
+		Id   string `json:"id"`
+		Body string `json:"body"`
+	} `json:"operations"`
+}
+
+func (r *PersistedOperationsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_persisted_operations"
+}
+
+func (r *PersistedOperationsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Persisted operations (trusted documents) of a client of a federated graph. The controlplane does not support deleting persisted operations, so operations removed from the configuration and destroying the resource only affect the terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the client.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"federated_graph_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the federated graph the operations are published to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The namespace of the federated graph. Defaults to `default`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the client the operations belong to. The client is created when the operations are published for the first time.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operations": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The operations to publish, mapped from their id to their contents. Conflicts with `manifest_file`.",
+				Optional:            true,
+			},
+			"manifest_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a manifest JSON file with the operations to publish, in the Apollo persisted query manifest or the Relay format. Conflicts with `operations`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("operations")),
+				},
+			},
+			"operation_hashes": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The SHA-256 hashes of the contents of the published operations, mapped from their id.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *PersistedOperationsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ParsePersistedOperationsManifest returns the operations of an Apollo persisted query manifest or a Relay
+// manifest, mapped from their id to their contents.
+func ParsePersistedOperationsManifest(data []byte) (map[string]string, error) {
+	var apollo apolloManifest
+	if err := json.Unmarshal(data, &apollo); err == nil && apollo.Format != "" {
+		if apollo.Format != apolloManifestFormat {
+			return nil, fmt.Errorf("unsupported manifest format: %s", apollo.Format)
+		}
+
+		operations := make(map[string]string, len(apollo.Operations))
+		for _, v := range apollo.Operations {
+			if v.Id == "" {
+				return nil, fmt.Errorf("manifest contains an operation without id")
+			}
+			operations[v.Id] = v.Body
+		}
+
+		return operations, nil
+	}
+
+	// Relay manifests are a plain object of ids mapped to the operation contents.
+	var relay map[string]string
+	if err := json.Unmarshal(data, &relay); err != nil {
+		return nil, fmt.Errorf("manifest is neither an Apollo persisted query manifest nor a Relay manifest: %w", err)
+	}
+
+	return relay, nil
+}
+
+// HashOperation returns the hex encoded SHA-256 hash of the contents of an operation.
+func HashOperation(contents string) string {
+	sum := sha256.Sum256([]byte(contents))
+	return hex.EncodeToString(sum[:])
+}
+
+func MapPersistedOperationsToNative(operations map[string]string) []*platformv1.PersistedOperation {
+	ids := make([]string, 0, len(operations))
+	for k := range operations {
+		ids = append(ids, k)
+	}
+	sort.Strings(ids)
+
+	var o []*platformv1.PersistedOperation
+	for _, id := range ids {
+		o = append(o, &platformv1.PersistedOperation{
+			Id:       id,
+			Contents: operations[id],
+		})
+	}
+
+	return o
+}
+
+func HashPersistedOperations(operations map[string]string) map[string]string {
+	h := make(map[string]string, len(operations))
+	for k, v := range operations {
+		h[k] = HashOperation(v)
+	}
+
+	return h
+}
+
+// resolveOperations returns the configured operations, read from the manifest file when one is set.
+func (r *PersistedOperationsResource) resolveOperations(ctx context.Context, data *PersistedOperationsModel) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if data.ManifestFile.IsNull() {
+		var operations map[string]string
+		diags.Append(data.Operations.ElementsAs(ctx, &operations, false)...)
+		return operations, diags
+	}
+
+	content, err := os.ReadFile(data.ManifestFile.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("manifest_file"), "Error reading manifest file", err.Error())
+		return nil, diags
+	}
+
+	operations, err := ParsePersistedOperationsManifest(content)
+	if err != nil {
+		diags.AddAttributeError(path.Root("manifest_file"), "Error parsing manifest file", err.Error())
+		return nil, diags
+	}
+
+	return operations, diags
+}
+
+func (r *PersistedOperationsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *PersistedOperationsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Operations.IsUnknown() || plan.ManifestFile.IsUnknown() {
+		plan.OperationHashes = types.MapUnknown(types.StringType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	// The hashes are planned from the configured operations, so the plan shows which operations are added or changed.
+	operations, diags := r.resolveOperations(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hashes, diags := types.MapValueFrom(ctx, types.StringType, HashPersistedOperations(operations))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.OperationHashes = hashes
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *PersistedOperationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *PersistedOperationsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.publish(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PersistedOperationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *PersistedOperationsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rc, err := r.client.GetClients(ctx, &connect.Request[platformv1.GetClientsRequest]{
+		Msg: &platformv1.GetClientsRequest{
+			FedGraphName: data.FederatedGraphName.ValueString(),
+			Namespace:    data.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading persisted operations", err.Error())
+		return
+	}
+
+	// The federated graph has been removed outside of terraform.
+	if rc.Msg.GetResponse().Code == common.EnumStatusCode_ERR_NOT_FOUND {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if rc.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error reading persisted operations", rc.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	var client *platformv1.ClientInfo
+	for _, c := range rc.Msg.Clients {
+		if c.Name == data.ClientName.ValueString() {
+			client = c
+			break
+		}
+	}
+
+	if client == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	rg, err := r.client.GetPersistedOperations(ctx, &connect.Request[platformv1.GetPersistedOperationsRequest]{
+		Msg: &platformv1.GetPersistedOperationsRequest{
+			FederatedGraphName: data.FederatedGraphName.ValueString(),
+			ClientId:           client.Id,
+			Namespace:          data.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading persisted operations", err.Error())
+		return
+	}
+
+	if rg.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error reading persisted operations", rg.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	published := make(map[string]string, len(rg.Msg.Operations))
+	for _, o := range rg.Msg.Operations {
+		published[o.Id] = o.Contents
+	}
+
+	var hashes map[string]string
+	resp.Diagnostics.Append(data.OperationHashes.ElementsAs(ctx, &hashes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the operations managed by this resource are tracked. Operations that are missing or have
+	// different contents show up as a change in the next plan, so they are published again.
+	current := make(map[string]string, len(hashes))
+	for id := range hashes {
+		if contents, ok := published[id]; ok {
+			current[id] = HashOperation(contents)
+		}
+	}
+
+	operationHashes, diags := types.MapValueFrom(ctx, types.StringType, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(client.Id)
+	data.OperationHashes = operationHashes
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersistedOperationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *PersistedOperationsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.publish(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PersistedOperationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Persisted operations cannot be deleted through the controlplane, so they are only removed from the state.
+	resp.Diagnostics.AddWarning(
+		"Persisted operations not deleted",
+		"The controlplane does not support deleting persisted operations, they have only been removed from the terraform state.",
+	)
+}
+
+// publish publishes the planned operations and sets the computed attributes of the plan.
+func (r *PersistedOperationsResource) publish(ctx context.Context, plan *PersistedOperationsModel, diags *diag.Diagnostics) {
+	operations, d := r.resolveOperations(ctx, plan)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	rp, err := r.client.PublishPersistedOperations(ctx, &connect.Request[platformv1.PublishPersistedOperationsRequest]{
+		Msg: &platformv1.PublishPersistedOperationsRequest{
+			FedGraphName: plan.FederatedGraphName.ValueString(),
+			ClientName:   plan.ClientName.ValueString(),
+			Operations:   MapPersistedOperationsToNative(operations),
+			Namespace:    plan.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		diags.AddError("Error publishing persisted operations", err.Error())
+		return
+	}
+
+	if rp.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		diags.AddError("Error publishing persisted operations", rp.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	var conflicts []string
+	for _, o := range rp.Msg.Operations {
+		if o.Status == platformv1.PublishedOperationStatus_CONFLICT {
+			conflicts = append(conflicts, o.Id)
+		}
+	}
+
+	if len(conflicts) > 0 {
+		diags.AddError(
+			"Error publishing persisted operations",
+			fmt.Sprintf("The following operations have already been published with different contents: %s", strings.Join(conflicts, ", ")),
+		)
+		return
+	}
+
+	hashes, d := types.MapValueFrom(ctx, types.StringType, HashPersistedOperations(operations))
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	rc, err := r.client.GetClients(ctx, &connect.Request[platformv1.GetClientsRequest]{
+		Msg: &platformv1.GetClientsRequest{
+			FedGraphName: plan.FederatedGraphName.ValueString(),
+			Namespace:    plan.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		diags.AddError("Error reading client", err.Error())
+		return
+	}
+
+	if rc.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		diags.AddError("Error reading client", rc.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	for _, c := range rc.Msg.Clients {
+		if c.Name == plan.ClientName.ValueString() {
+			plan.Id = types.StringValue(c.Id)
+		}
+	}
+
+	if plan.Id.IsUnknown() || plan.Id.IsNull() {
+		diags.AddError("Error reading client", "client not found")
+		return
+	}
+
+	plan.OperationHashes = hashes
+}
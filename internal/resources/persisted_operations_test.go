@@ -0,0 +1,97 @@
+package resources
+
+import (
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePersistedOperationsManifest(t *testing.T) {
+	tests := []struct {
+		name        string
+		manifest    string
+		expected    map[string]string
+		expectError bool
+	}{
+		{
+			name: "ApolloManifest",
+			manifest: `{
+				"format": "apollo-persisted-query-manifest",
+				"version": 1,
+				"operations": [
+					{"id": "a1", "name": "Employees", "type": "query", "body": "query Employees { employees { id } }"}
+				]
+			}`,
+			expected: map[string]string{"a1": "query Employees { employees { id } }"},
+		},
+		{
+			name:     "RelayManifest",
+			manifest: `{"a1": "query Employees { employees { id } }", "b2": "query Teams { teams { id } }"}`,
+			expected: map[string]string{"a1": "query Employees { employees { id } }", "b2": "query Teams { teams { id } }"},
+		},
+		{
+			name:        "UnsupportedFormat",
+			manifest:    `{"format": "unknown-manifest", "operations": []}`,
+			expectError: true,
+		},
+		{
+			name:        "ApolloManifestWithoutId",
+			manifest:    `{"format": "apollo-persisted-query-manifest", "operations": [{"body": "query { a }"}]}`,
+			expectError: true,
+		},
+		{
+			name:        "InvalidJson",
+			manifest:    `["query { a }"]`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParsePersistedOperationsManifest([]byte(tt.manifest))
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestHashOperation(t *testing.T) {
+	assert.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", HashOperation(""))
+	assert.Equal(t, HashOperation("query { a }"), HashOperation("query { a }"))
+	assert.NotEqual(t, HashOperation("query { a }"), HashOperation("query { b }"))
+}
+
+func TestMapPersistedOperationsToNative(t *testing.T) {
+	tests := []struct {
+		name       string
+		operations map[string]string
+		expected   []*platformv1.PersistedOperation
+	}{
+		{
+			name:       "SortedById",
+			operations: map[string]string{"b2": "query { b }", "a1": "query { a }"},
+			expected: []*platformv1.PersistedOperation{
+				{Id: "a1", Contents: "query { a }"},
+				{Id: "b2", Contents: "query { b }"},
+			},
+		},
+		{
+			name:       "EmptyInput",
+			operations: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := MapPersistedOperationsToNative(tt.operations)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package resources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RouterTokenResource{}
+
+func NewRouterTokenResource() resource.Resource {
+	return &RouterTokenResource{}
+}
+
+// RouterTokenResource defines the resource implementation.
+type RouterTokenResource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// RouterTokenModel describes the resource data model.
+type RouterTokenModel struct {
+	Id        types.String `tfsdk:"id"`
+	GraphName types.String `tfsdk:"graph_name"`
+	Namespace types.String `tfsdk:"namespace"`
+	TokenName types.String `tfsdk:"token_name"`
+	Token     types.String `tfsdk:"token"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func (r *RouterTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_router_token"
+}
+
+func (r *RouterTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Router token, used by the router to authenticate against the controlplane for a federated graph.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the federated graph or monograph the token is created for.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The namespace name of the graph. Defaults to `default`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the token. It must be unique within the graph.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The token value to configure in the router. It is only returned when the token is created.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time at which the token was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RouterTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RouterTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *RouterTokenModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rc, err := r.client.CreateFederatedGraphToken(ctx, &connect.Request[platformv1.CreateFederatedGraphTokenRequest]{
+		Msg: &platformv1.CreateFederatedGraphTokenRequest{
+			GraphName: plan.GraphName.ValueString(),
+			Namespace: plan.Namespace.ValueString(),
+			TokenName: plan.TokenName.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating router token", err.Error())
+		return
+	}
+
+	if rc.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error creating router token", rc.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	// The token is only returned once, so we save it before looking up the id in case the lookup fails.
+	plan.Token = types.StringValue(rc.Msg.Token)
+	plan.Id = types.StringNull()
+	plan.CreatedAt = types.StringNull()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// We fetch the token list to get the id of the token, as it is not part of the create response.
+	token, err := r.findToken(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading router token", err.Error())
+		return
+	}
+
+	if token == nil {
+		resp.Diagnostics.AddError("Error reading router token", "router token not found")
+		return
+	}
+
+	plan.Id = types.StringValue(token.Id)
+	plan.CreatedAt = types.StringValue(token.CreatedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RouterTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *RouterTokenModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.findToken(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading router token", err.Error())
+		return
+	}
+
+	// The token has been revoked outside of terraform, so it has to be created again.
+	if token == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.StringValue(token.Id)
+	data.CreatedAt = types.StringValue(token.CreatedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RouterTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require a replacement, so there is nothing to update in place.
+	var plan RouterTokenModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RouterTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *RouterTokenModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rd, err := r.client.DeleteRouterToken(ctx, &connect.Request[platformv1.DeleteRouterTokenRequest]{
+		Msg: &platformv1.DeleteRouterTokenRequest{
+			TokenName:    data.TokenName.ValueString(),
+			FedGraphName: data.GraphName.ValueString(),
+			Namespace:    data.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting router token", err.Error())
+		return
+	}
+
+	if rd.Msg.GetResponse().Code != common.EnumStatusCode_OK && rd.Msg.GetResponse().Code != common.EnumStatusCode_ERR_NOT_FOUND {
+		resp.Diagnostics.AddError("Error deleting router token", rd.Msg.GetResponse().GetDetails())
+		return
+	}
+}
+
+// findToken looks up the token by its name in the token list of the graph. It returns nil if the token does not exist.
+func (r *RouterTokenResource) findToken(ctx context.Context, data *RouterTokenModel) (*platformv1.RouterToken, error) {
+	rt, err := r.client.GetRouterTokens(ctx, &connect.Request[platformv1.GetRouterTokensRequest]{
+		Msg: &platformv1.GetRouterTokensRequest{
+			FedGraphName: data.GraphName.ValueString(),
+			Namespace:    data.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// When the graph itself has been removed, its tokens are gone as well.
+	if rt.Msg.GetResponse().Code == common.EnumStatusCode_ERR_NOT_FOUND {
+		return nil, nil
+	}
+
+	if rt.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		return nil, fmt.Errorf("error fetching router token list: %s", rt.Msg.GetResponse().GetDetails())
+	}
+
+	for _, t := range rt.Msg.Tokens {
+		if t.Name == data.TokenName.ValueString() {
+			return t, nil
+		}
+	}
+
+	return nil, nil
+}
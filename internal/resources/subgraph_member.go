@@ -0,0 +1,247 @@
+package resources
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/common"
+	platformv1 "github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1"
+	"github.com/labd/terraform-provider-wundergraph/sdk/wg/cosmo/platform/v1/platformv1connect"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SubgraphMemberResource{}
+
+func NewSubgraphMemberResource() resource.Resource {
+	return &SubgraphMemberResource{}
+}
+
+// SubgraphMemberResource defines the resource implementation.
+type SubgraphMemberResource struct {
+	client platformv1connect.PlatformServiceClient
+}
+
+// SubgraphMemberModel describes the resource data model.
+type SubgraphMemberModel struct {
+	Id           types.String `tfsdk:"id"`
+	SubgraphName types.String `tfsdk:"subgraph_name"`
+	Namespace    types.String `tfsdk:"namespace"`
+	Email        types.String `tfsdk:"email"`
+	UserId       types.String `tfsdk:"user_id"`
+}
+
+func (r *SubgraphMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subgraph_member"
+}
+
+func (r *SubgraphMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Member of a subgraph. When RBAC is enabled for the organization, only subgraph members are allowed to publish the subgraph.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subgraph_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the subgraph.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The namespace of the subgraph. Defaults to `default`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the user. The user has to be a member of the organization.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the user.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SubgraphMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(platformv1connect.PlatformServiceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SubgraphMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *SubgraphMemberModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rc, err := r.client.AddSubgraphMember(ctx, &connect.Request[platformv1.AddSubgraphMemberRequest]{
+		Msg: &platformv1.AddSubgraphMemberRequest{
+			SubgraphName: plan.SubgraphName.ValueString(),
+			UserEmail:    plan.Email.ValueString(),
+			Namespace:    plan.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating subgraph member", err.Error())
+		return
+	}
+
+	if rc.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		resp.Diagnostics.AddError("Error creating subgraph member", rc.Msg.GetResponse().GetDetails())
+		return
+	}
+
+	// We fetch the member list to get the requested member, as the create response does not contain its id.
+	member, err := r.findMember(ctx, plan, func(m *platformv1.SubgraphMember) bool {
+		return strings.EqualFold(m.Email, plan.Email.ValueString())
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading subgraph member", err.Error())
+		return
+	}
+
+	if member == nil {
+		resp.Diagnostics.AddError("Error reading subgraph member", "subgraph member not found")
+		return
+	}
+
+	plan.Id = types.StringValue(member.SubgraphMemberId)
+	plan.UserId = types.StringValue(member.UserId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SubgraphMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SubgraphMemberModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.findMember(ctx, data, func(m *platformv1.SubgraphMember) bool {
+		return m.SubgraphMemberId == data.Id.ValueString()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading subgraph member", err.Error())
+		return
+	}
+
+	// The member has been removed outside of terraform.
+	if member == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The email is kept from the state when it only differs in case, to prevent a replacement.
+	if !strings.EqualFold(member.Email, data.Email.ValueString()) {
+		data.Email = types.StringValue(member.Email)
+	}
+	data.UserId = types.StringValue(member.UserId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubgraphMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require a replacement, so there is nothing to update in place.
+	var plan SubgraphMemberModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SubgraphMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SubgraphMemberModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rd, err := r.client.RemoveSubgraphMember(ctx, &connect.Request[platformv1.RemoveSubgraphMemberRequest]{
+		Msg: &platformv1.RemoveSubgraphMemberRequest{
+			SubgraphName:     data.SubgraphName.ValueString(),
+			SubgraphMemberId: data.Id.ValueString(),
+			Namespace:        data.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting subgraph member", err.Error())
+		return
+	}
+
+	if rd.Msg.GetResponse().Code != common.EnumStatusCode_OK && rd.Msg.GetResponse().Code != common.EnumStatusCode_ERR_NOT_FOUND {
+		resp.Diagnostics.AddError("Error deleting subgraph member", rd.Msg.GetResponse().GetDetails())
+		return
+	}
+}
+
+// findMember returns the first member of the subgraph matching the predicate, or nil if there is none.
+func (r *SubgraphMemberResource) findMember(ctx context.Context, data *SubgraphMemberModel, match func(*platformv1.SubgraphMember) bool) (*platformv1.SubgraphMember, error) {
+	rg, err := r.client.GetSubgraphMembers(ctx, &connect.Request[platformv1.GetSubgraphMembersRequest]{
+		Msg: &platformv1.GetSubgraphMembersRequest{
+			SubgraphName: data.SubgraphName.ValueString(),
+			Namespace:    data.Namespace.ValueString(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The subgraph itself has been removed, so it has no members anymore.
+	if rg.Msg.GetResponse().Code == common.EnumStatusCode_ERR_NOT_FOUND {
+		return nil, nil
+	}
+
+	if rg.Msg.GetResponse().Code != common.EnumStatusCode_OK {
+		return nil, fmt.Errorf("error fetching subgraph member list: %s", rg.Msg.GetResponse().GetDetails())
+	}
+
+	for _, m := range rg.Msg.Members {
+		if match(m) {
+			return m, nil
+		}
+	}
+
+	return nil, nil
+}